@@ -15,7 +15,9 @@ import (
 	"github.com/stpnv0/SalesTracker/internal/middleware"
 	"github.com/stpnv0/SalesTracker/internal/repository"
 	"github.com/stpnv0/SalesTracker/internal/router"
+	"github.com/stpnv0/SalesTracker/internal/scheduler"
 	"github.com/stpnv0/SalesTracker/internal/service"
+	"github.com/stpnv0/SalesTracker/internal/storage"
 	"github.com/wb-go/wbf/dbpg"
 	"github.com/wb-go/wbf/logger"
 	"github.com/wb-go/wbf/retry"
@@ -28,6 +30,7 @@ type App struct {
 	log        logger.Logger
 	db         *dbpg.DB
 	httpServer *http.Server
+	scheduler  *scheduler.Scheduler
 }
 
 func New(cfg *config.Config, log logger.Logger) (*App, error) {
@@ -83,23 +86,58 @@ func (a *App) initServices() error {
 	}
 	analyticsRepo := repository.NewAnalyticsRepo(a.db, strategy)
 	itemRepo := repository.NewItemRepo(a.db, strategy)
+	rateRepo := repository.NewRateRepo(a.db, strategy)
+	idempotencyRepo := repository.NewIdempotencyRepo(a.db, strategy)
+	recurringRepo := repository.NewRecurringRepo(a.db, strategy)
+	categoryRepo := repository.NewCategoryRepo(a.db, strategy)
+	accountRepo := repository.NewAccountRepo(a.db, strategy)
+	transferRepo := repository.NewTransferRepo(a.db, strategy)
+	attachmentRepo := repository.NewAttachmentRepo(a.db, strategy)
+
+	blobs, err := storage.New(a.cfg.Storage)
+	if err != nil {
+		return fmt.Errorf("init storage: %w", err)
+	}
 
 	analyticsService := service.NewAnalyticsService(analyticsRepo)
-	itemService := service.NewItemService(itemRepo)
+	itemService := service.NewItemService(itemRepo, categoryRepo, blobs)
+	rateService := service.NewRateService(rateRepo)
+	recurringService := service.NewRecurringService(recurringRepo, itemService, categoryRepo)
+	categoryService := service.NewCategoryService(categoryRepo)
+	accountService := service.NewAccountService(accountRepo)
+	transferService := service.NewTransferService(transferRepo, accountRepo)
+	attachmentService := service.NewAttachmentService(attachmentRepo, blobs, a.cfg.Storage.MaxUploadSize)
 
 	itemHandler := handler.NewItemHandler(itemService, a.log)
 	analyticsHandler := handler.NewAnalyticsHandler(analyticsService, a.log)
 	exportHandler := handler.NewExportHandler(itemService, a.log)
+	importHandler := handler.NewImportHandler(itemService, a.log)
+	rateHandler := handler.NewRateHandler(rateService, a.log)
+	recurringHandler := handler.NewRecurringHandler(recurringService, a.log)
+	categoryHandler := handler.NewCategoryHandler(categoryService, a.log)
+	accountHandler := handler.NewAccountHandler(accountService, a.log)
+	transferHandler := handler.NewTransferHandler(transferService, a.log)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService, a.cfg.Storage.MaxUploadSize, a.log)
 	r := router.InitRouter(
 		a.cfg.Gin.Mode,
 		itemHandler,
 		analyticsHandler,
 		exportHandler,
+		importHandler,
+		rateHandler,
+		recurringHandler,
+		categoryHandler,
+		accountHandler,
+		transferHandler,
+		attachmentHandler,
+		middleware.Idempotency(idempotencyRepo, a.cfg.Idempotency.TTL, a.log),
 		middleware.CORS(),
 		middleware.RequestID(),
 		middleware.RequestLogger(a.log),
 	)
 
+	a.scheduler = scheduler.New(recurringService, idempotencyRepo, a.cfg.Scheduler.Interval, a.log)
+
 	a.httpServer = &http.Server{
 		Addr:         a.cfg.Server.Addr,
 		Handler:      r,
@@ -115,6 +153,8 @@ func (a *App) Run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	a.scheduler.Start()
+
 	errCh := make(chan error, 1)
 	go func() {
 		a.log.LogAttrs(ctx, logger.InfoLevel, "HTTP server starting",
@@ -149,6 +189,8 @@ func (a *App) shutdown() error {
 	}
 	a.log.LogAttrs(context.Background(), logger.InfoLevel, "HTTP server stopped")
 
+	a.scheduler.Stop()
+
 	if err := a.db.Master.Close(); err != nil {
 		return fmt.Errorf("close db: %w", err)
 	}
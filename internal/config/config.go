@@ -14,6 +14,10 @@ type Config struct {
 	Logger   LoggerConfig   `yaml:"logger"    validate:"required"`
 	Gin      GinConfig      `yaml:"gin"       validate:"required"`
 	Retry    RetryConfig    `yaml:"retry"`
+
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	Scheduler   SchedulerConfig   `yaml:"scheduler"`
+	Storage     StorageConfig     `yaml:"storage"`
 }
 
 // LogLevel преобразует строковый уровень в logger.Level из wbf.
@@ -77,6 +81,21 @@ type RetryConfig struct {
 	Backoff  float64       `yaml:"backoff"  env:"RETRY_BACKOFF"  env-default:"2"     validate:"min=1"`
 }
 
+type IdempotencyConfig struct {
+	TTL time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h" validate:"gt=0"`
+}
+
+type SchedulerConfig struct {
+	Interval time.Duration `yaml:"interval" env:"SCHEDULER_INTERVAL" env-default:"1m" validate:"gt=0"`
+}
+
+// StorageConfig — хранилище файлов вложений. Backend выбирает реализацию, пока есть только local.
+type StorageConfig struct {
+	Backend       string `yaml:"backend"         env:"STORAGE_BACKEND"         env-default:"local"            validate:"required,oneof=local"`
+	LocalDir      string `yaml:"local_dir"       env:"STORAGE_LOCAL_DIR"       env-default:"data/attachments" validate:"required"`
+	MaxUploadSize int64  `yaml:"max_upload_size" env:"STORAGE_MAX_UPLOAD_SIZE" env-default:"10485760"         validate:"min=1"`
+}
+
 func MustLoad() *Config {
 	var cfg Config
 	if err := cleanenvport.Load(&cfg); err != nil {
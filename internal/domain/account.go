@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Account — счёт или кошелёк. Записи счёта ведутся только в его валюте.
+type Account struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Currency       string          `json:"currency"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	// Closed — на закрытый счёт нельзя добавлять записи, старые записи не меняются.
+	Closed    bool      `json:"closed"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AccountBalance — остаток счёта на конец дня At.
+type AccountBalance struct {
+	AccountID string          `json:"account_id"`
+	Currency  string          `json:"currency"`
+	At        time.Time       `json:"at"`
+	Balance   decimal.Decimal `json:"balance"`
+}
+
+// StatementFilter — параметры выписки по счёту.
+type StatementFilter struct {
+	AccountID string
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+	Offset    int
+}
+
+func (f StatementFilter) Validate() error {
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
+// StatementEntry — запись выписки с остатком счёта после неё.
+type StatementEntry struct {
+	Item
+	Balance decimal.Decimal `json:"balance"`
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Attachment — файл, приложенный к записи (чек, квитанция). Содержимое хранится отдельно
+// от базы, по ключу StorageKey.
+type Attachment struct {
+	ID         string    `json:"id"`
+	ItemID     string    `json:"item_id"`
+	FileName   string    `json:"file_name"`
+	MimeType   string    `json:"mime_type"`
+	Size       int64     `json:"size"`
+	StorageKey string    `json:"-"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// attachmentTypes — допустимые типы вложений; тип определяется по содержимому файла.
+var attachmentTypes = map[string]struct{}{
+	"image/jpeg":      {},
+	"image/png":       {},
+	"image/webp":      {},
+	"application/pdf": {},
+}
+
+// IsAttachmentType сообщает, можно ли приложить файл типа mimeType.
+func IsAttachmentType(mimeType string) bool {
+	_, ok := attachmentTypes[mimeType]
+	return ok
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "fmt"
+
+const (
+	BatchOpCreate = "create"
+	BatchOpUpdate = "update"
+	BatchOpDelete = "delete"
+)
+
+// MaxBatchSize — максимальное число операций в одном пакете.
+const MaxBatchSize = 1000
+
+// BatchOperation — одна строка пакета. Для delete используется только Item.ID,
+// для update ненулевой Item.Version включает проверку версии.
+type BatchOperation struct {
+	Index int // позиция в исходном запросе
+	Op    string
+	Item  Item
+}
+
+type BatchResult struct {
+	Index int
+	Op    string
+	Item  *Item // nil для delete и при ошибке
+	Err   error // заполняется только в неатомарном режиме
+}
+
+// BatchOpError — ошибка строки, из-за которой откатился атомарный пакет.
+type BatchOpError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchOpError) Error() string {
+	return fmt.Sprintf("operation %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *BatchOpError) Unwrap() error {
+	return e.Err
+}
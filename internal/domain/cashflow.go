@@ -0,0 +1,48 @@
+package domain
+
+import "github.com/shopspring/decimal"
+
+// CashflowReport — движение денег по интервалам, отдельно по каждой валюте.
+type CashflowReport struct {
+	GroupBy      string           `json:"group_by"`
+	Currencies   []CashflowResult `json:"currencies"`
+	BaseCurrency string           `json:"base_currency,omitempty"`
+	// Unconverted — записи, исключённые из расчёта из-за отсутствия курса (только при BaseCurrency).
+	Unconverted []UnconvertedItem `json:"unconverted,omitempty"`
+}
+
+// CashflowResult — итоги за весь период и разбивка по интервалам в одной валюте.
+type CashflowResult struct {
+	Currency string           `json:"currency"`
+	Income   decimal.Decimal  `json:"income"`
+	Expense  decimal.Decimal  `json:"expense"`
+	Net      decimal.Decimal  `json:"net"`
+	Buckets  []CashflowBucket `json:"buckets"`
+}
+
+// CashflowBucket — доходы и расходы за интервал, их разница и нарастающий итог разницы
+// с начала периода. Интервалы без записей возвращаются только при fill=zero.
+type CashflowBucket struct {
+	Key           string          `json:"key"`
+	Currency      string          `json:"-"`
+	Income        decimal.Decimal `json:"income"`
+	Expense       decimal.Decimal `json:"expense"`
+	Net           decimal.Decimal `json:"net"`
+	CumulativeNet decimal.Decimal `json:"cumulative_net"`
+}
+
+// ValidateCashflow проверяет фильтр для отчёта о движении денег: он сам делит записи
+// на доходы и расходы, поэтому type, compare и второе измерение не поддерживаются,
+// а группировка — только по времени.
+func (f AnalyticsFilter) ValidateCashflow() error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	if !IsTimeGroupBy(f.GroupBy) {
+		return ErrInvalidCashflowGroupBy
+	}
+	if f.Type != "" || f.Compare != CompareNone || f.PivotBy != "" {
+		return ErrCashflowFilter
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Category — категория записей. Записи ссылаются на неё по имени.
+type Category struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	ParentID *string `json:"parent_id"`
+	Type     string  `json:"type,omitempty"` // пусто — подходит и для доходов, и для расходов
+	// Archived — категорию нельзя выбрать для новых записей, старые записи не меняются.
+	Archived  bool      `json:"archived"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Allows сообщает, можно ли отнести к категории запись типа itemType.
+func (c Category) Allows(itemType string) bool {
+	return c.Type == "" || c.Type == itemType
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ItemCursor — позиция в списке записей для keyset-пагинации: значение поля сортировки
+// и id последней записи страницы. Клиенту передаётся непрозрачной строкой (Encode).
+type ItemCursor struct {
+	SortBy string `json:"s"`
+	Order  string `json:"o"`
+	Key    string `json:"k"` // значение поля сортировки в текстовом виде
+	ID     string `json:"id"`
+}
+
+// ItemPage — страница списка записей.
+type ItemPage struct {
+	Items      []Item
+	TotalCount *int64 // nil, если подсчёт отключён
+	NextCursor string // пусто на последней странице
+}
+
+func (c ItemCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeItemCursor разбирает строку курсора и проверяет, что значение ключа подходит полю сортировки.
+func DecodeItemCursor(s string) (ItemCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ItemCursor{}, ErrInvalidCursor
+	}
+	var c ItemCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return ItemCursor{}, ErrInvalidCursor
+	}
+	if c.Order != OrderAsc && c.Order != OrderDesc {
+		return ItemCursor{}, ErrInvalidCursor
+	}
+
+	switch c.SortBy {
+	case SortByDate:
+		_, err = time.Parse("2006-01-02", c.Key)
+	case SortByAmount:
+		_, err = decimal.NewFromString(c.Key)
+	case SortByRelevance:
+		_, err = strconv.ParseFloat(c.Key, 32)
+	case SortByCategory, SortByType:
+	default:
+		return ItemCursor{}, ErrInvalidCursor
+	}
+	if err != nil {
+		return ItemCursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
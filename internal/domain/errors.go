@@ -3,17 +3,66 @@ package domain
 import "errors"
 
 var (
-	ErrInvalidType      = errors.New("type must be 'income' or 'expense'")
-	ErrInvalidAmount    = errors.New("amount must be greater than zero")
-	ErrEmptyCategory    = errors.New("category must not be empty")
-	ErrInvalidDate      = errors.New("date must not be zero")
-	ErrInvalidID        = errors.New("id must be a valid UUID")
-	ErrItemNotFound     = errors.New("item not found")
-	ErrInvalidSortBy    = errors.New("sort_by must be one of: date, amount, category, type")
-	ErrInvalidOrder     = errors.New("order must be 'asc' or 'desc'")
-	ErrInvalidGroupBy   = errors.New("group_by must be one of: day, week, month, category")
-	ErrInvalidDateRange = errors.New("'from' date must not be after 'to' date")
-	ErrValidation       = errors.New("validation error")
+	ErrInvalidType            = errors.New("type must be 'income' or 'expense'")
+	ErrInvalidAmount          = errors.New("amount must be greater than zero")
+	ErrEmptyCategory          = errors.New("category must not be empty")
+	ErrInvalidDate            = errors.New("date must not be zero")
+	ErrInvalidID              = errors.New("id must be a valid UUID")
+	ErrItemNotFound           = errors.New("item not found")
+	ErrInvalidSortBy          = errors.New("sort_by must be one of: date, amount, category, type, relevance")
+	ErrInvalidOrder           = errors.New("order must be 'asc' or 'desc'")
+	ErrInvalidGroupBy         = errors.New("group_by must be one of: day, week, month, quarter, year, category, category_tree, tag")
+	ErrInvalidDateRange       = errors.New("'from' date must not be after 'to' date")
+	ErrValidation             = errors.New("validation error")
+	ErrInvalidCurrency        = errors.New("currency must be a 3-letter ISO 4217 code")
+	ErrRateNotFound           = errors.New("exchange rate not found")
+	ErrRateExists             = errors.New("exchange rate for this date and currency pair already exists")
+	ErrInvalidPurgeAge        = errors.New("older_than_days must be a non-negative integer")
+	ErrConflict               = errors.New("item has been modified by another request")
+	ErrInvalidBatchOp         = errors.New("op must be one of [create update delete]")
+	ErrInvalidBatchSize       = errors.New("batch must contain from 1 to 1000 operations")
+	ErrItemExists             = errors.New("item with this id already exists")
+	ErrRecurringNotFound      = errors.New("recurring item not found")
+	ErrInvalidPreviewCount    = errors.New("count must be between 1 and 100")
+	ErrCategoryNotFound       = errors.New("category not found")
+	ErrCategoryExists         = errors.New("category with this name already exists")
+	ErrCategoryInUse          = errors.New("category is used by items or has subcategories")
+	ErrUnknownCategory        = errors.New("category does not exist")
+	ErrCategoryArchived       = errors.New("category is archived")
+	ErrCategoryType           = errors.New("category does not allow this item type")
+	ErrParentCategory         = errors.New("parent category not found")
+	ErrCategoryCycle          = errors.New("category cannot be nested under itself or its subcategory")
+	ErrInvalidTagMatch        = errors.New("tag_match must be 'any' or 'all'")
+	ErrAccountNotFound        = errors.New("account not found")
+	ErrAccountExists          = errors.New("account with this name already exists")
+	ErrAccountInUse           = errors.New("account is used by items")
+	ErrUnknownAccount         = errors.New("account does not exist")
+	ErrAccountClosed          = errors.New("account is closed")
+	ErrAccountCurrency        = errors.New("item currency must match account currency")
+	ErrTransferNotFound       = errors.New("transfer not found")
+	ErrSameAccount            = errors.New("transfer accounts must differ")
+	ErrTransferAmount         = errors.New("to_amount is required for accounts in different currencies and not allowed otherwise")
+	ErrTransferLeg            = errors.New("transfer items can only be changed through /api/transfers")
+	ErrSplitAmount            = errors.New("split amount must be greater than zero")
+	ErrSplitSum               = errors.New("splits must add up to the item amount")
+	ErrRelevanceWithoutQuery  = errors.New("sort_by=relevance requires q")
+	ErrInvalidAmountRange     = errors.New("min_amount and max_amount must be non-negative and min_amount must not exceed max_amount")
+	ErrInvalidCursor          = errors.New("cursor is invalid or was issued for a different sort_by or order")
+	ErrCursorWithOffset       = errors.New("cursor cannot be combined with offset")
+	ErrInvalidCompare         = errors.New("compare must be 'previous_period' or 'previous_year'")
+	ErrInvalidCashflowGroupBy = errors.New("group_by for cashflow must be one of: day, week, month, quarter, year")
+	ErrCashflowFilter         = errors.New("type, compare and two-dimensional group_by are not supported for cashflow")
+	ErrInvalidWeekStart       = errors.New("week_start must be a day of the week, e.g. 'monday' or 'sunday'")
+	ErrInvalidTimezone        = errors.New("tz must be an IANA time zone, e.g. 'America/New_York'")
+	ErrInvalidFill            = errors.New("fill must be 'zero' and requires group_by day, week, month, quarter or year")
+	ErrInvalidPivot           = errors.New("two-dimensional group_by must combine day, week, month, quarter or year with category or type")
+	ErrPivotCompare           = errors.New("compare is not supported with two-dimensional group_by")
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrEmptyAttachment        = errors.New("attachment file must not be empty")
+	ErrAttachmentTooLarge     = errors.New("attachment file is too large")
+	ErrAttachmentType         = errors.New("attachment must be a JPEG, PNG or WebP image or a PDF")
+	// ErrAttachmentCleanup — операция выполнена, но часть файлов вложений не удалось удалить из хранилища.
+	ErrAttachmentCleanup = errors.New("failed to delete attachment files")
 )
 
 var validationErrors = []error{
@@ -27,6 +76,38 @@ var validationErrors = []error{
 	ErrInvalidOrder,
 	ErrInvalidGroupBy,
 	ErrInvalidDateRange,
+	ErrInvalidCurrency,
+	ErrInvalidPurgeAge,
+	ErrInvalidBatchOp,
+	ErrInvalidBatchSize,
+	ErrInvalidPreviewCount,
+	ErrUnknownCategory,
+	ErrCategoryArchived,
+	ErrCategoryType,
+	ErrParentCategory,
+	ErrCategoryCycle,
+	ErrInvalidTagMatch,
+	ErrUnknownAccount,
+	ErrAccountClosed,
+	ErrAccountCurrency,
+	ErrSameAccount,
+	ErrTransferAmount,
+	ErrTransferLeg,
+	ErrSplitAmount,
+	ErrSplitSum,
+	ErrEmptyAttachment,
+	ErrRelevanceWithoutQuery,
+	ErrInvalidAmountRange,
+	ErrInvalidCursor,
+	ErrCursorWithOffset,
+	ErrInvalidCompare,
+	ErrInvalidCashflowGroupBy,
+	ErrCashflowFilter,
+	ErrInvalidWeekStart,
+	ErrInvalidTimezone,
+	ErrInvalidFill,
+	ErrInvalidPivot,
+	ErrPivotCompare,
 }
 
 func IsValidationError(err error) bool {
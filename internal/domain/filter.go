@@ -1,26 +1,70 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FieldFilter — условия на сумму, категорию и описание записи, общие для списка, экспорта и аналитики.
+type FieldFilter struct {
+	MinAmount         *decimal.Decimal // включительно, в валюте записи
+	MaxAmount         *decimal.Decimal
+	Categories        []string // запись в любой из категорий
+	ExcludeCategories []string
+	DescriptionPrefix string // без учёта регистра
+}
+
+func (f FieldFilter) Validate() error {
+	if (f.MinAmount != nil && f.MinAmount.IsNegative()) || (f.MaxAmount != nil && f.MaxAmount.IsNegative()) {
+		return ErrInvalidAmountRange
+	}
+	if f.MinAmount != nil && f.MaxAmount != nil && f.MinAmount.GreaterThan(*f.MaxAmount) {
+		return ErrInvalidAmountRange
+	}
+	for _, names := range [][]string{f.Categories, f.ExcludeCategories} {
+		for _, name := range names {
+			if name == "" {
+				return ErrEmptyCategory
+			}
+		}
+	}
+	return nil
+}
 
 type ItemFilter struct {
-	From     *time.Time
-	To       *time.Time
-	Category string
-	Type     string
-	SortBy   string
-	Order    string
-	Limit    int
-	Offset   int
-	NoLimit  bool // true для экспорта — отключает пагинацию
+	From      *time.Time
+	To        *time.Time
+	Type      string
+	Tags      []string
+	TagMatch  string // any (по умолчанию) или all
+	AccountID string
+	Query     string // полнотекстовый поиск по описанию (синтаксис websearch)
+	SortBy    string
+	Order     string
+	Limit     int
+	Offset    int
+	NoLimit   bool        // true для экспорта — отключает пагинацию
+	Deleted   bool        // true — только записи из корзины, иначе только живые
+	Cursor    *ItemCursor // продолжить после записи из курсора вместо Offset
+	SkipTotal bool        // true — не считать общее количество записей
+	FieldFilter
 }
 
 func (f ItemFilter) Validate() error {
 	if f.Type != "" && f.Type != TypeIncome && f.Type != TypeExpense {
 		return ErrInvalidType
 	}
+	if f.TagMatch != "" && f.TagMatch != TagMatchAny && f.TagMatch != TagMatchAll {
+		return ErrInvalidTagMatch
+	}
 	if f.SortBy != "" {
 		switch f.SortBy {
 		case SortByDate, SortByAmount, SortByCategory, SortByType:
+		case SortByRelevance:
+			if f.Query == "" {
+				return ErrRelevanceWithoutQuery
+			}
 		default:
 			return ErrInvalidSortBy
 		}
@@ -31,14 +75,48 @@ func (f ItemFilter) Validate() error {
 	if f.From != nil && f.To != nil && f.From.After(*f.To) {
 		return ErrInvalidDateRange
 	}
-	return nil
+	if f.Cursor != nil {
+		if f.Offset > 0 {
+			return ErrCursorWithOffset
+		}
+		// курсор действует только для той сортировки, в которой был выдан
+		if sortBy, order := f.Sort(); f.Cursor.SortBy != sortBy || f.Cursor.Order != order {
+			return ErrInvalidCursor
+		}
+	}
+	return f.FieldFilter.Validate()
+}
+
+// Sort возвращает поле и направление сортировки с учётом значений по умолчанию (date, desc).
+func (f ItemFilter) Sort() (sortBy, order string) {
+	sortBy, order = f.SortBy, f.Order
+	if sortBy == "" {
+		sortBy = SortByDate
+	}
+	if order == "" {
+		order = OrderDesc
+	}
+	return sortBy, order
 }
 
 type AnalyticsFilter struct {
 	From    time.Time
 	To      time.Time
 	GroupBy string
-	Type    string
+	// PivotBy — второе измерение (category или type) при group_by=<время>,<измерение>:
+	// тогда GroupBy задаёт строки, а PivotBy — столбцы матрицы.
+	PivotBy      string
+	Type         string
+	BaseCurrency string // если задана — все суммы пересчитываются в неё по курсу на дату записи
+	// IncludeTransfers — учитывать переводы между счетами; по умолчанию они не считаются ни доходом, ни расходом.
+	IncludeTransfers bool
+	Compare          string // previous_period или previous_year — добавить период сравнения
+	WeekStart        string // первый день недели для group_by=week: monday (по умолчанию) … sunday
+	Fill             string // zero — дополнить группы по времени пустыми интервалами
+	// Timezone — часовой пояс IANA. Если задан, датой записи считается день её создания (created_at)
+	// в этом поясе: по нему отбирается период и строятся интервалы вместо календарной даты date.
+	Timezone string
+	FieldFilter
 }
 
 func (f AnalyticsFilter) Validate() error {
@@ -50,13 +128,85 @@ func (f AnalyticsFilter) Validate() error {
 	}
 	if f.GroupBy != "" {
 		switch f.GroupBy {
-		case GroupByDay, GroupByWeek, GroupByMonth, GroupByCategory:
+		case GroupByDay, GroupByWeek, GroupByMonth, GroupByQuarter, GroupByYear,
+			GroupByCategory, GroupByCategoryTree, GroupByTag:
 		default:
 			return ErrInvalidGroupBy
 		}
 	}
+	if f.PivotBy != "" {
+		if !IsTimeGroupBy(f.GroupBy) || (f.PivotBy != GroupByCategory && f.PivotBy != GroupByType) {
+			return ErrInvalidPivot
+		}
+		if f.Compare != CompareNone {
+			return ErrPivotCompare
+		}
+	}
 	if f.Type != "" && f.Type != TypeIncome && f.Type != TypeExpense {
 		return ErrInvalidType
 	}
-	return nil
+	if f.BaseCurrency != "" && !IsValidCurrency(f.BaseCurrency) {
+		return ErrInvalidCurrency
+	}
+	switch f.Compare {
+	case CompareNone, ComparePreviousPeriod, ComparePreviousYear:
+	default:
+		return ErrInvalidCompare
+	}
+	if f.Fill != "" && (f.Fill != FillZero || !IsTimeGroupBy(f.GroupBy)) {
+		return ErrInvalidFill
+	}
+	if _, ok := weekdays[f.WeekStart]; f.WeekStart != "" && !ok {
+		return ErrInvalidWeekStart
+	}
+	if f.Timezone != "" {
+		if _, err := time.LoadLocation(f.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+	}
+	return f.FieldFilter.Validate()
+}
+
+var weekdays = map[string]time.Weekday{
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+	"sunday":    time.Sunday,
+}
+
+// WeekStartDay возвращает первый день недели для group_by=week.
+func (f AnalyticsFilter) WeekStartDay() time.Weekday {
+	if d, ok := weekdays[f.WeekStart]; ok {
+		return d
+	}
+	return time.Monday
+}
+
+// ComparisonPeriod возвращает границы периода сравнения для f.Compare.
+// Период из целых календарных месяцев сравнивается с таким же числом предыдущих месяцев,
+// иной период — с тем же числом дней непосредственно перед ним. Для previous_year
+// 29 февраля переходит в 28-е.
+func (f AnalyticsFilter) ComparisonPeriod() (from, to time.Time) {
+	if f.Compare == ComparePreviousYear {
+		return yearEarlier(f.From), yearEarlier(f.To)
+	}
+
+	if f.From.Day() == 1 && f.To.AddDate(0, 0, 1).Day() == 1 {
+		months := (f.To.Year()-f.From.Year())*12 + int(f.To.Month()-f.From.Month()) + 1
+		return f.From.AddDate(0, -months, 0), f.From.AddDate(0, 0, -1)
+	}
+	days := int(f.To.Sub(f.From).Hours()/24) + 1
+	return f.From.AddDate(0, 0, -days), f.From.AddDate(0, 0, -1)
+}
+
+func yearEarlier(t time.Time) time.Time {
+	prev := t.AddDate(-1, 0, 0)
+	if prev.Day() != t.Day() {
+		// AddDate нормализует 29.02 в 01.03 — откатываемся на последний день февраля
+		prev = prev.AddDate(0, 0, -prev.Day())
+	}
+	return prev
 }
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+const (
+	HistoryActionCreate  = "create"
+	HistoryActionUpdate  = "update"
+	HistoryActionDelete  = "delete"
+	HistoryActionRestore = "restore"
+	HistoryActionPurge   = "purge"
+)
+
+// ItemHistoryEntry — одно изменение записи. OldValue пуст для create, NewValue — для delete.
+type ItemHistoryEntry struct {
+	ID        int64     `json:"id"`
+	ItemID    string    `json:"item_id"`
+	Action    string    `json:"action"`
+	OldValue  *Item     `json:"old_value"`
+	NewValue  *Item     `json:"new_value"`
+	RequestID string    `json:"request_id"`
+	ChangedAt time.Time `json:"changed_at"`
+}
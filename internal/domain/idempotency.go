@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord — сохранённый ответ на запрос с заголовком Idempotency-Key.
+// StatusCode == 0 означает, что исходный запрос ещё выполняется.
+type IdempotencyRecord struct {
+	Key         string
+	RequestHash string
+	StatusCode  int
+	Headers     map[string]string
+	Response    []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// InProgress сообщает, что ответ на исходный запрос ещё не сохранён.
+func (r IdempotencyRecord) InProgress() bool {
+	return r.StatusCode == 0
+}
@@ -11,11 +11,16 @@ const (
 	TypeExpense = "expense"
 )
 
+// DefaultCurrency — валюта записи, если клиент её не указал.
+const DefaultCurrency = "RUB"
+
 const (
 	SortByDate     = "date"
 	SortByAmount   = "amount"
 	SortByCategory = "category"
 	SortByType     = "type"
+	// SortByRelevance — по релевантности полнотекстового поиска, только вместе с q.
+	SortByRelevance = "relevance"
 )
 
 const (
@@ -27,34 +32,155 @@ const (
 	GroupByDay      = "day"
 	GroupByWeek     = "week"
 	GroupByMonth    = "month"
+	GroupByQuarter  = "quarter"
+	GroupByYear     = "year"
 	GroupByCategory = "category"
+	// GroupByCategoryTree — по дереву категорий: родитель считается по записям всех потомков.
+	GroupByCategoryTree = "category_tree"
+	// GroupByTag — по тегам: запись с несколькими тегами попадает в каждую их группу.
+	GroupByTag = "tag"
+	// GroupByType — по типу записи, только как второе измерение (group_by=month,type).
+	GroupByType = "type"
+)
+
+// IsTimeGroupBy сообщает, что группировка идёт по интервалам времени.
+func IsTimeGroupBy(groupBy string) bool {
+	switch groupBy {
+	case GroupByDay, GroupByWeek, GroupByMonth, GroupByQuarter, GroupByYear:
+		return true
+	}
+	return false
+}
+
+// FillZero — вернуть все интервалы периода, пустые — с нулями.
+const FillZero = "zero"
+
+const (
+	// CompareNone — без периода сравнения.
+	CompareNone = ""
+	// ComparePreviousPeriod — такой же длины период непосредственно перед текущим.
+	ComparePreviousPeriod = "previous_period"
+	// ComparePreviousYear — те же даты годом раньше.
+	ComparePreviousYear = "previous_year"
 )
 
 type Item struct {
 	ID          string          `json:"id"`
 	Type        string          `json:"type"`
 	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency"`
 	Category    string          `json:"category"`
 	Description string          `json:"description"`
+	Tags        []string        `json:"tags"`
+	Splits      []ItemSplit     `json:"splits"`      // пусто — запись целиком в своей категории
+	AccountID   *string         `json:"account_id"`  // nil — запись не привязана к счёту
+	TransferID  *string         `json:"transfer_id"` // не nil — запись является половиной перевода
 	Date        time.Time       `json:"date"`
 	CreatedAt   time.Time       `json:"created_at"`
 	UpdatedAt   time.Time       `json:"updated_at"`
+	DeletedAt   *time.Time      `json:"deleted_at,omitempty"` // не nil — запись в корзине
+	Version     int64           `json:"version"`              // растёт при каждом изменении, основа ETag
+}
+
+// ItemPatch — частичное изменение записи: nil-поля остаются как есть.
+type ItemPatch struct {
+	ID          string
+	Version     int64 // ожидаемая версия, 0 — без проверки
+	Type        *string
+	Amount      *decimal.Decimal
+	Currency    *string
+	Category    *string
+	Description *string
+	Tags        *[]string    // заменяет набор тегов целиком
+	Splits      *[]ItemSplit // заменяет разбивку целиком, пустой список её снимает
+	AccountID   *string      // пустая строка отвязывает запись от счёта
+	Date        *time.Time
+	UpdatedAt   time.Time
+}
+
+// IsEmpty сообщает, что патч не меняет ни одного поля.
+func (p ItemPatch) IsEmpty() bool {
+	return p.Type == nil && p.Amount == nil && p.Currency == nil &&
+		p.Category == nil && p.Description == nil && p.Tags == nil && p.Splits == nil && p.AccountID == nil && p.Date == nil
+}
+
+// AnalyticsReport — аналитика, разбитая по валютам: суммы в разных валютах никогда не складываются.
+type AnalyticsReport struct {
+	Currencies   []AnalyticsResult `json:"currencies"`
+	BaseCurrency string            `json:"base_currency,omitempty"`
+	// Unconverted — записи, исключённые из расчёта из-за отсутствия курса (только при BaseCurrency).
+	Unconverted []UnconvertedItem `json:"unconverted,omitempty"`
+	// Comparison — аналитика периода сравнения (только при Compare).
+	Comparison *AnalyticsComparison `json:"comparison,omitempty"`
+}
+
+// AnalyticsComparison — аналитика периода, с которым сравнивается текущий.
+type AnalyticsComparison struct {
+	Compare     string            `json:"compare"`
+	From        time.Time         `json:"from"`
+	To          time.Time         `json:"to"`
+	Currencies  []AnalyticsResult `json:"currencies"`
+	Unconverted []UnconvertedItem `json:"unconverted,omitempty"`
+}
+
+// AnalyticsDelta — изменение итогов относительно периода сравнения.
+// Проценты равны nil, если в периоде сравнения было ноль.
+type AnalyticsDelta struct {
+	TotalSum        decimal.Decimal  `json:"total_sum"`
+	TotalSumPercent *decimal.Decimal `json:"total_sum_percent"`
+	Count           int64            `json:"count"`
+	CountPercent    *decimal.Decimal `json:"count_percent"`
 }
 
 type AnalyticsResult struct {
+	Currency string             `json:"currency"`
 	TotalSum decimal.Decimal    `json:"total_sum"`
 	Avg      decimal.Decimal    `json:"avg"`
 	Count    int64              `json:"count"`
 	Median   decimal.Decimal    `json:"median"`
 	P90      decimal.Decimal    `json:"p90"`
 	Groups   []GroupedAnalytics `json:"groups,omitempty"`
+	Delta    *AnalyticsDelta    `json:"delta,omitempty"` // только при Compare
+	Pivot    *AnalyticsPivot    `json:"pivot,omitempty"` // только при PivotBy
 }
 
 type GroupedAnalytics struct {
-	Key      string          `json:"key"`
-	TotalSum decimal.Decimal `json:"total_sum"`
-	Avg      decimal.Decimal `json:"avg"`
-	Count    int64           `json:"count"`
-	Median   decimal.Decimal `json:"median"`
-	P90      decimal.Decimal `json:"p90"`
+	Key      string             `json:"key"`
+	Currency string             `json:"currency"`
+	TotalSum decimal.Decimal    `json:"total_sum"`
+	Avg      decimal.Decimal    `json:"avg"`
+	Count    int64              `json:"count"`
+	Median   decimal.Decimal    `json:"median"`
+	P90      decimal.Decimal    `json:"p90"`
+	Children []GroupedAnalytics `json:"children,omitempty"` // только для category_tree
+	Delta    *AnalyticsDelta    `json:"delta,omitempty"`    // только при Compare
+}
+
+// AnalyticsPivot — статистика в разрезе двух измерений: строки — интервалы времени (RowsBy),
+// столбцы — категории или типы записей (ColumnsBy). Cells[i][j] — ячейка строки Rows[i]
+// и столбца Columns[j]; у сочетаний без записей статистика нулевая.
+type AnalyticsPivot struct {
+	RowsBy    string        `json:"rows_by"`
+	ColumnsBy string        `json:"columns_by"`
+	Rows      []string      `json:"rows"`
+	Columns   []string      `json:"columns"`
+	Cells     [][]PivotCell `json:"cells"`
+}
+
+type PivotCell struct {
+	RowKey    string          `json:"-"`
+	ColumnKey string          `json:"-"`
+	Currency  string          `json:"-"`
+	TotalSum  decimal.Decimal `json:"total_sum"`
+	Avg       decimal.Decimal `json:"avg"`
+	Count     int64           `json:"count"`
+	Median    decimal.Decimal `json:"median"`
+	P90       decimal.Decimal `json:"p90"`
+}
+
+// CategoryTreeGroup — статистика категории по её записям и записям всех её потомков.
+type CategoryTreeGroup struct {
+	CategoryID string
+	ParentID   *string
+	GroupedAnalytics
 }
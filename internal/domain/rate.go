@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate — курс From→To, действующий начиная с Date и до следующей записи по той же паре.
+type ExchangeRate struct {
+	ID        string          `json:"id"`
+	Date      time.Time       `json:"date"`
+	From      string          `json:"from"`
+	To        string          `json:"to"`
+	Rate      decimal.Decimal `json:"rate"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// UnconvertedItem — запись, для которой не нашлось курса в базовую валюту на её дату.
+type UnconvertedItem struct {
+	ItemID   string          `json:"item_id"`
+	Currency string          `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
+	Date     time.Time       `json:"date"`
+}
+
+type RateFilter struct {
+	From         *time.Time
+	To           *time.Time
+	FromCurrency string
+	ToCurrency   string
+}
+
+func (f RateFilter) Validate() error {
+	if f.FromCurrency != "" && !IsValidCurrency(f.FromCurrency) {
+		return ErrInvalidCurrency
+	}
+	if f.ToCurrency != "" && !IsValidCurrency(f.ToCurrency) {
+		return ErrInvalidCurrency
+	}
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
+// IsValidCurrency проверяет формат кода валюты ISO 4217 (три заглавные латинские буквы).
+func IsValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+	FrequencyYearly  = "yearly"
+)
+
+// recurringNamespace — пространство имён UUIDv5 для записей, созданных по расписанию.
+var recurringNamespace = uuid.MustParse("6f1d7c1e-2b8a-4f4e-9d0c-5a3e8b7f2c11")
+
+// RecurringItem — шаблон повторяющейся записи. Повторения нумеруются с нуля от StartDate;
+// OccurrenceCount — сколько из них уже обработано, NextRun — дата следующего (nil, если серия закончилась).
+type RecurringItem struct {
+	ID              string          `json:"id"`
+	Type            string          `json:"type"`
+	Amount          decimal.Decimal `json:"amount"`
+	Currency        string          `json:"currency"`
+	Category        string          `json:"category"`
+	Description     string          `json:"description"`
+	Frequency       string          `json:"frequency"`
+	Interval        int             `json:"interval"`
+	StartDate       time.Time       `json:"start_date"`
+	EndDate         *time.Time      `json:"end_date,omitempty"`
+	OccurrenceCount int             `json:"occurrence_count"`
+	NextRun         *time.Time      `json:"next_run"`
+	Paused          bool            `json:"paused"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// Occurrence возвращает дату n-го повторения; false — серия к этому моменту закончилась.
+// Для monthly/yearly день месяца прижимается к концу короткого месяца (31 янв → 28 фев → 31 мар).
+func (r RecurringItem) Occurrence(n int) (time.Time, bool) {
+	var date time.Time
+	switch r.Frequency {
+	case FrequencyDaily:
+		date = r.StartDate.AddDate(0, 0, n*r.Interval)
+	case FrequencyWeekly:
+		date = r.StartDate.AddDate(0, 0, 7*n*r.Interval)
+	case FrequencyMonthly:
+		date = addMonthsClamped(r.StartDate, n*r.Interval)
+	case FrequencyYearly:
+		date = addMonthsClamped(r.StartDate, 12*n*r.Interval)
+	default:
+		return time.Time{}, false
+	}
+
+	if r.EndDate != nil && date.After(*r.EndDate) {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// Upcoming возвращает до count дат, начиная с первого необработанного повторения.
+func (r RecurringItem) Upcoming(count int) []time.Time {
+	dates := make([]time.Time, 0, count)
+	for n := r.OccurrenceCount; len(dates) < count; n++ {
+		date, ok := r.Occurrence(n)
+		if !ok {
+			break
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// SkipTo переводит серию на первое повторение не раньше date и пересчитывает NextRun.
+func (r *RecurringItem) SkipTo(date time.Time) {
+	for {
+		next, ok := r.Occurrence(r.OccurrenceCount)
+		if !ok {
+			r.NextRun = nil
+			return
+		}
+		if !next.Before(date) {
+			r.NextRun = &next
+			return
+		}
+		r.OccurrenceCount++
+	}
+}
+
+// ItemFor собирает запись для повторения на date. ID детерминирован по шаблону и дате,
+// поэтому повторная попытка создать ту же запись упирается в PRIMARY KEY.
+func (r RecurringItem) ItemFor(date time.Time) Item {
+	now := time.Now().UTC()
+	return Item{
+		ID:          uuid.NewSHA1(recurringNamespace, []byte(r.ID+"/"+date.Format("2006-01-02"))).String(),
+		Type:        r.Type,
+		Amount:      r.Amount,
+		Currency:    r.Currency,
+		Category:    r.Category,
+		Description: r.Description,
+		Date:        date,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if d > lastDay {
+		d = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, t.Location())
+}
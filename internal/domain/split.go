@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxItemSplits — сколько строк разбивки может быть у одной записи.
+const MaxItemSplits = 50
+
+// ItemSplit — строка разбивки: часть суммы записи, отнесённая к своей категории.
+type ItemSplit struct {
+	Category string          `json:"category"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// ValidateSplits проверяет, что строки разбивки положительны и в сумме дают amount.
+// Пустая разбивка допустима: запись целиком относится к своей категории.
+func ValidateSplits(amount decimal.Decimal, splits []ItemSplit) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	sum := decimal.Zero
+	for i, s := range splits {
+		if !s.Amount.IsPositive() {
+			return fmt.Errorf("%w: split %d", ErrSplitAmount, i+1)
+		}
+		sum = sum.Add(s.Amount)
+	}
+	if !sum.Equal(amount) {
+		return fmt.Errorf("%w: splits total %s, item amount %s", ErrSplitSum, sum.String(), amount.String())
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// TagMatchAny — запись подходит, если у неё есть хотя бы один из тегов фильтра.
+	TagMatchAny = "any"
+	// TagMatchAll — запись подходит, только если у неё есть все теги фильтра.
+	TagMatchAll = "all"
+)
+
+// MaxItemTags — сколько тегов можно повесить на одну запись.
+const MaxItemTags = 20
+
+// NormalizeTags приводит теги к нижнему регистру без пробелов по краям, убирает пустые
+// и повторы и сортирует. Результат никогда не nil, чтобы в JSON всегда был массив.
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		res = append(res, t)
+	}
+	sort.Strings(res)
+	return res
+}
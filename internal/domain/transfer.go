@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TransferCategory — категория записей-половин перевода.
+const TransferCategory = "transfer"
+
+// Transfer — перевод между счетами: расход со счёта From и доход на счёт To.
+// Обе записи создаются и удаляются вместе и отдельно не редактируются.
+type Transfer struct {
+	ID   string `json:"id"`
+	From Item   `json:"from"`
+	To   Item   `json:"to"`
+}
+
+// TransferParams — данные для создания перевода.
+type TransferParams struct {
+	FromAccountID string
+	ToAccountID   string
+	Amount        decimal.Decimal
+	ToAmount      *decimal.Decimal // сумма зачисления, только для счетов в разных валютах
+	Description   string
+	Date          time.Time
+	CreatedAt     time.Time
+}
@@ -13,7 +13,7 @@ func WriteCSV(w io.Writer, items []domain.Item) error {
 
 	if err := cw.Write([]string{
 		"id", "type", "amount", "category",
-		"description", "date", "created_at", "updated_at",
+		"description", "date", "currency", "created_at", "updated_at",
 	}); err != nil {
 		return err
 	}
@@ -26,6 +26,7 @@ func WriteCSV(w io.Writer, items []domain.Item) error {
 			item.Category,
 			item.Description,
 			item.Date.Format("2006-01-02"),
+			item.Currency,
 			item.CreatedAt.Format(time.RFC3339),
 			item.UpdatedAt.Format(time.RFC3339),
 		}); err != nil {
@@ -22,6 +22,7 @@ func TestWriteCSV_Success(t *testing.T) {
 			ID:          "id-1",
 			Type:        domain.TypeIncome,
 			Amount:      decimal.NewFromFloat(150.50),
+			Currency:    "USD",
 			Category:    "salary",
 			Description: "monthly salary",
 			Date:        testDate,
@@ -35,10 +36,11 @@ func TestWriteCSV_Success(t *testing.T) {
 
 	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
 	assert.Len(t, lines, 2)
-	assert.Equal(t, "id,type,amount,category,description,date,created_at,updated_at", lines[0])
+	assert.Equal(t, "id,type,amount,category,description,date,currency,created_at,updated_at", lines[0])
 	assert.Contains(t, lines[1], "id-1")
 	assert.Contains(t, lines[1], "income")
 	assert.Contains(t, lines[1], "150.50")
+	assert.Contains(t, lines[1], "USD")
 	assert.Contains(t, lines[1], "salary")
 	assert.Contains(t, lines[1], "monthly salary")
 	assert.Contains(t, lines[1], "2024-06-15")
@@ -52,7 +54,7 @@ func TestWriteCSV_EmptyItems(t *testing.T) {
 
 	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
 	assert.Len(t, lines, 1)
-	assert.Equal(t, "id,type,amount,category,description,date,created_at,updated_at", lines[0])
+	assert.Equal(t, "id,type,amount,category,description,date,currency,created_at,updated_at", lines[0])
 }
 
 func TestWriteCSV_MultipleItems(t *testing.T) {
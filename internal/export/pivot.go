@@ -0,0 +1,79 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+var pivotMetrics = map[string]func(domain.PivotCell) string{
+	"total_sum": func(c domain.PivotCell) string { return c.TotalSum.StringFixed(2) },
+	"count":     func(c domain.PivotCell) string { return strconv.FormatInt(c.Count, 10) },
+	"avg":       func(c domain.PivotCell) string { return c.Avg.StringFixed(2) },
+	"median":    func(c domain.PivotCell) string { return c.Median.StringFixed(2) },
+	"p90":       func(c domain.PivotCell) string { return c.P90.StringFixed(2) },
+}
+
+// IsPivotMetric сообщает, что показатель ячейки можно выгрузить в WritePivotCSV.
+func IsPivotMetric(metric string) bool {
+	_, ok := pivotMetrics[metric]
+	return ok
+}
+
+// WritePivotCSV пишет сводную таблицу в широком виде: строка на каждый интервал каждой валюты,
+// столбец на каждое значение второго измерения из всех валют; в ячейках — показатель metric.
+func WritePivotCSV(w io.Writer, rowsBy string, results []domain.AnalyticsResult, metric string) error {
+	value, ok := pivotMetrics[metric]
+	if !ok {
+		value = pivotMetrics["total_sum"]
+	}
+
+	seen := make(map[string]bool)
+	var columns []string
+	for _, r := range results {
+		if r.Pivot == nil {
+			continue
+		}
+		for _, col := range r.Pivot.Columns {
+			if !seen[col] {
+				seen[col] = true
+				columns = append(columns, col)
+			}
+		}
+	}
+	sort.Strings(columns)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"currency", rowsBy}, columns...)); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if r.Pivot == nil {
+			continue
+		}
+		colIdx := make(map[string]int, len(r.Pivot.Columns))
+		for j, col := range r.Pivot.Columns {
+			colIdx[col] = j
+		}
+		for i, row := range r.Pivot.Rows {
+			record := []string{r.Currency, row}
+			for _, col := range columns {
+				var cell domain.PivotCell
+				if j, ok := colIdx[col]; ok {
+					cell = r.Pivot.Cells[i][j]
+				}
+				record = append(record, value(cell))
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWritePivotCSV(t *testing.T) {
+	var buf bytes.Buffer
+	results := []domain.AnalyticsResult{
+		{
+			Currency: "EUR",
+			Pivot: &domain.AnalyticsPivot{
+				Rows:    []string{"2024-01-01"},
+				Columns: []string{"Travel"},
+				Cells:   [][]domain.PivotCell{{{TotalSum: decimal.NewFromInt(80)}}},
+			},
+		},
+		{
+			Currency: "RUB",
+			Pivot: &domain.AnalyticsPivot{
+				Rows:    []string{"2024-01-01", "2024-02-01"},
+				Columns: []string{"Food"},
+				Cells: [][]domain.PivotCell{
+					{{TotalSum: decimal.NewFromFloat(150.5)}},
+					{{TotalSum: decimal.NewFromInt(200)}},
+				},
+			},
+		},
+	}
+
+	err := WritePivotCSV(&buf, domain.GroupByMonth, results, "total_sum")
+	require.NoError(t, err)
+
+	// столбцы — объединение по всем валютам; чего у валюты нет, выгружается нулём
+	assert.Equal(t, "currency,month,Food,Travel\n"+
+		"EUR,2024-01-01,0.00,80.00\n"+
+		"RUB,2024-01-01,150.50,0.00\n"+
+		"RUB,2024-02-01,200.00,0.00\n", buf.String())
+}
+
+func TestIsPivotMetric(t *testing.T) {
+	assert.True(t, IsPivotMetric("p90"))
+	assert.False(t, IsPivotMetric("sum"))
+}
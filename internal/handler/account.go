@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+type accountService interface {
+	Create(ctx context.Context, acc domain.Account) (domain.Account, error)
+	List(ctx context.Context, includeClosed bool) ([]domain.Account, error)
+	GetByID(ctx context.Context, id string) (domain.Account, error)
+	Update(ctx context.Context, acc domain.Account) (domain.Account, error)
+	Delete(ctx context.Context, id string) error
+	Balance(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error)
+	Statement(ctx context.Context, filter domain.StatementFilter) (domain.Account, []domain.StatementEntry, int64, error)
+}
+
+type AccountHandler struct {
+	svc accountService
+	log logger.Logger
+}
+
+func NewAccountHandler(svc accountService, log logger.Logger) *AccountHandler {
+	return &AccountHandler{
+		svc: svc,
+		log: log,
+	}
+}
+
+// Create - POST /api/accounts.
+func (h *AccountHandler) Create(c *ginext.Context) {
+	var req AccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	created, err := h.svc.Create(c.Request.Context(), req.ToAccount(""))
+	if err != nil {
+		h.respondAccountError(c, err, "create account")
+		return
+	}
+
+	respondJSON(c, http.StatusCreated, created)
+}
+
+// List - GET /api/accounts?include_closed=true.
+func (h *AccountHandler) List(c *ginext.Context) {
+	includeClosed := false
+	if v := c.Query("include_closed"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "invalid 'include_closed' parameter")
+			return
+		}
+		includeClosed = b
+	}
+
+	accounts, err := h.svc.List(c.Request.Context(), includeClosed)
+	if err != nil {
+		h.respondAccountError(c, err, "list accounts")
+		return
+	}
+
+	if accounts == nil {
+		accounts = []domain.Account{}
+	}
+	respondJSON(c, http.StatusOK, map[string]interface{}{"accounts": accounts})
+}
+
+// GetByID - GET /api/accounts/:id.
+func (h *AccountHandler) GetByID(c *ginext.Context) {
+	acc, err := h.svc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondAccountError(c, err, "get account by id")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, acc)
+}
+
+// Update - PUT /api/accounts/:id.
+func (h *AccountHandler) Update(c *ginext.Context) {
+	var req AccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated, err := h.svc.Update(c.Request.Context(), req.ToAccount(c.Param("id")))
+	if err != nil {
+		h.respondAccountError(c, err, "update account")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, updated)
+}
+
+// Delete - DELETE /api/accounts/:id.
+func (h *AccountHandler) Delete(c *ginext.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondAccountError(c, err, "delete account")
+		return
+	}
+
+	respondNoContent(c)
+}
+
+// Balance - GET /api/accounts/:id/balance?at=YYYY-MM-DD, по умолчанию на сегодня.
+func (h *AccountHandler) Balance(c *ginext.Context) {
+	at := time.Now().UTC().Truncate(24 * time.Hour)
+	if v := c.Query("at"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "invalid 'at' date format, expected YYYY-MM-DD")
+			return
+		}
+		at = t
+	}
+
+	balance, err := h.svc.Balance(c.Request.Context(), c.Param("id"), at)
+	if err != nil {
+		h.respondAccountError(c, err, "get account balance")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, balance)
+}
+
+// Statement - GET /api/accounts/:id/statement?from=...&to=...&limit=...&offset=...
+func (h *AccountHandler) Statement(c *ginext.Context) {
+	filter, err := parseStatementFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	acc, entries, total, err := h.svc.Statement(c.Request.Context(), filter)
+	if err != nil {
+		h.respondAccountError(c, err, "get account statement")
+		return
+	}
+
+	if entries == nil {
+		entries = []domain.StatementEntry{}
+	}
+	respondJSON(c, http.StatusOK, map[string]interface{}{
+		"account":     acc,
+		"entries":     entries,
+		"total_count": total,
+	})
+}
+
+func parseStatementFilter(c *ginext.Context) (domain.StatementFilter, error) {
+	filter := domain.StatementFilter{AccountID: c.Param("id")}
+
+	if v := c.Query("from"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return filter, errors.New("invalid 'from' date format, expected YYYY-MM-DD")
+		}
+		filter.From = &t
+	}
+	if v := c.Query("to"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return filter, errors.New("invalid 'to' date format, expected YYYY-MM-DD")
+		}
+		filter.To = &t
+	}
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return filter, errors.New("invalid 'limit' parameter")
+		}
+		filter.Limit = n
+	}
+	if v := c.Query("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return filter, errors.New("invalid 'offset' parameter")
+		}
+		filter.Offset = n
+	}
+
+	return filter, nil
+}
+
+func (h *AccountHandler) respondAccountError(c *ginext.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrAccountNotFound):
+		respondError(c, http.StatusNotFound, "account not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		respondError(c, http.StatusBadRequest, "invalid account id")
+	case errors.Is(err, domain.ErrAccountExists), errors.Is(err, domain.ErrAccountInUse):
+		respondError(c, http.StatusConflict, err.Error())
+	case domain.IsValidationError(err):
+		respondError(c, http.StatusBadRequest, err.Error())
+	default:
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, msg,
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAccountRouter(h *AccountHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/accounts", gin.HandlerFunc(h.Create))
+	r.GET("/api/accounts", gin.HandlerFunc(h.List))
+	r.GET("/api/accounts/:id", gin.HandlerFunc(h.GetByID))
+	r.PUT("/api/accounts/:id", gin.HandlerFunc(h.Update))
+	r.DELETE("/api/accounts/:id", gin.HandlerFunc(h.Delete))
+	r.GET("/api/accounts/:id/balance", gin.HandlerFunc(h.Balance))
+	r.GET("/api/accounts/:id/statement", gin.HandlerFunc(h.Statement))
+	return r
+}
+
+func testAccount() domain.Account {
+	return domain.Account{ID: testItemID(), Name: "card", Currency: "RUB", OpeningBalance: decimal.NewFromInt(1000)}
+}
+
+func TestAccountHandler_Create_Success(t *testing.T) {
+	svc := newMockaccountService(t)
+	h := NewAccountHandler(svc, newTestLogger(t))
+	router := setupAccountRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a domain.Account) bool {
+		return a.Name == "card" && a.Currency == domain.DefaultCurrency &&
+			a.OpeningBalance.Equal(decimal.NewFromInt(1000))
+	})).Return(testAccount(), nil)
+
+	body := `{"name":"card","opening_balance":1000}`
+	req := httptest.NewRequest(http.MethodPost, "/api/accounts", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestAccountHandler_Delete_InUse(t *testing.T) {
+	svc := newMockaccountService(t)
+	h := NewAccountHandler(svc, newTestLogger(t))
+	router := setupAccountRouter(h)
+
+	svc.EXPECT().Delete(mock.Anything, testItemID()).Return(domain.ErrAccountInUse)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/accounts/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestAccountHandler_Balance_At(t *testing.T) {
+	svc := newMockaccountService(t)
+	h := NewAccountHandler(svc, newTestLogger(t))
+	router := setupAccountRouter(h)
+
+	at := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	svc.EXPECT().Balance(mock.Anything, testItemID(), at).Return(domain.AccountBalance{
+		AccountID: testItemID(), Currency: "RUB", At: at, Balance: decimal.NewFromInt(1250),
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/accounts/"+testItemID()+"/balance?at=2024-06-30", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"balance":"1250"`)
+}
+
+func TestAccountHandler_Balance_InvalidDate(t *testing.T) {
+	svc := newMockaccountService(t)
+	h := NewAccountHandler(svc, newTestLogger(t))
+	router := setupAccountRouter(h)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/accounts/"+testItemID()+"/balance?at=30.06.2024", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAccountHandler_Balance_NotFound(t *testing.T) {
+	svc := newMockaccountService(t)
+	h := NewAccountHandler(svc, newTestLogger(t))
+	router := setupAccountRouter(h)
+
+	svc.EXPECT().Balance(mock.Anything, testItemID(), mock.Anything).Return(domain.AccountBalance{}, domain.ErrAccountNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/accounts/"+testItemID()+"/balance", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestAccountHandler_Statement_Success(t *testing.T) {
+	svc := newMockaccountService(t)
+	h := NewAccountHandler(svc, newTestLogger(t))
+	router := setupAccountRouter(h)
+
+	entry := domain.StatementEntry{Item: testItem(), Balance: decimal.NewFromInt(1100)}
+	svc.EXPECT().Statement(mock.Anything, mock.MatchedBy(func(f domain.StatementFilter) bool {
+		return f.AccountID == testItemID() && f.From != nil && f.To == nil && f.Limit == 10
+	})).Return(testAccount(), []domain.StatementEntry{entry}, int64(1), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/accounts/"+testItemID()+"/statement?from=2024-06-01&limit=10", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp struct {
+		Account    domain.Account `json:"account"`
+		Entries    []map[string]interface{}
+		TotalCount int64 `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "card", resp.Account.Name)
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, testItemID(), resp.Entries[0]["id"])
+	assert.Equal(t, "1100", resp.Entries[0]["balance"])
+	assert.Equal(t, int64(1), resp.TotalCount)
+}
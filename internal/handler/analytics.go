@@ -4,15 +4,19 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stpnv0/SalesTracker/internal/export"
 	"github.com/wb-go/wbf/ginext"
 	"github.com/wb-go/wbf/logger"
 )
 
 type analyticsService interface {
-	GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsResult, error)
+	GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsReport, error)
+	GetCashflow(ctx context.Context, filter domain.AnalyticsFilter) (domain.CashflowReport, error)
 }
 
 type AnalyticsHandler struct {
@@ -50,6 +54,70 @@ func (h *AnalyticsHandler) Get(c *ginext.Context) {
 	respondJSON(c, http.StatusOK, result)
 }
 
+// Cashflow - GET /api/analytics/cashflow.
+func (h *AnalyticsHandler) Cashflow(c *ginext.Context) {
+	filter, err := parseAnalyticsFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.svc.GetCashflow(c.Request.Context(), filter)
+	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "get cashflow",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, result)
+}
+
+// CSV - GET /api/export/analytics/csv — сводная таблица при двумерном group_by.
+func (h *AnalyticsHandler) CSV(c *ginext.Context) {
+	filter, err := parseAnalyticsFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if filter.PivotBy == "" {
+		respondError(c, http.StatusBadRequest, "group_by must have two dimensions, e.g. 'month,category'")
+		return
+	}
+	metric := c.Query("metric")
+	if metric == "" {
+		metric = "total_sum"
+	}
+	if !export.IsPivotMetric(metric) {
+		respondError(c, http.StatusBadRequest, "metric must be one of: total_sum, count, avg, median, p90")
+		return
+	}
+
+	report, err := h.svc.GetAnalytics(c.Request.Context(), filter)
+	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "export analytics csv",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment; filename=analytics.csv")
+
+	if err := export.WritePivotCSV(c.Writer, filter.GroupBy, report.Currencies, metric); err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "write analytics csv",
+			logger.String("error", err.Error()))
+	}
+}
+
 func parseAnalyticsFilter(c *ginext.Context) (domain.AnalyticsFilter, error) {
 	var filter domain.AnalyticsFilter
 
@@ -73,8 +141,41 @@ func parseAnalyticsFilter(c *ginext.Context) (domain.AnalyticsFilter, error) {
 	}
 	filter.To = to
 
-	filter.GroupBy = c.Query("group_by")
+	filter.GroupBy, filter.PivotBy = parseGroupBy(c.Query("group_by"))
 	filter.Type = c.Query("type")
+	filter.BaseCurrency = strings.ToUpper(c.Query("base_currency"))
+	filter.Compare = c.Query("compare")
+	filter.WeekStart = strings.ToLower(c.Query("week_start"))
+	filter.Timezone = c.Query("tz")
+	filter.Fill = c.Query("fill")
+
+	if v := c.Query("include_transfers"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.New("invalid 'include_transfers' parameter")
+		}
+		filter.IncludeTransfers = b
+	}
+
+	fields, err := parseFieldFilter(c)
+	if err != nil {
+		return filter, err
+	}
+	filter.FieldFilter = fields
 
 	return filter, nil
 }
+
+// parseGroupBy разбирает group_by из одного или двух измерений. Во втором случае измерение
+// времени всегда становится строками, а второе — столбцами: month,category и category,month равнозначны.
+func parseGroupBy(v string) (groupBy, pivotBy string) {
+	rows, cols, ok := strings.Cut(v, ",")
+	if !ok {
+		return v, ""
+	}
+	rows, cols = strings.TrimSpace(rows), strings.TrimSpace(cols)
+	if !domain.IsTimeGroupBy(rows) && domain.IsTimeGroupBy(cols) {
+		rows, cols = cols, rows
+	}
+	return rows, cols
+}
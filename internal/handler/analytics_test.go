@@ -19,6 +19,8 @@ func setupAnalyticsRouter(h *AnalyticsHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/api/analytics", gin.HandlerFunc(h.Get))
+	r.GET("/api/analytics/cashflow", gin.HandlerFunc(h.Cashflow))
+	r.GET("/api/export/analytics/csv", gin.HandlerFunc(h.CSV))
 	return r
 }
 
@@ -27,13 +29,14 @@ func TestAnalyticsHandler_Get_Success(t *testing.T) {
 	h := NewAnalyticsHandler(svc, newTestLogger(t))
 	router := setupAnalyticsRouter(h)
 
-	result := domain.AnalyticsResult{
+	result := domain.AnalyticsReport{Currencies: []domain.AnalyticsResult{{
+		Currency: "RUB",
 		TotalSum: decimal.NewFromInt(1000),
 		Avg:      decimal.NewFromInt(100),
 		Count:    10,
 		Median:   decimal.NewFromInt(90),
 		P90:      decimal.NewFromInt(200),
-	}
+	}}}
 	svc.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(result, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/analytics?from=2024-01-01&to=2024-12-31", nil)
@@ -43,10 +46,12 @@ func TestAnalyticsHandler_Get_Success(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 
-	var resp domain.AnalyticsResult
+	var resp domain.AnalyticsReport
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	assert.Equal(t, int64(10), resp.Count)
+	require.Len(t, resp.Currencies, 1)
+	assert.Equal(t, "RUB", resp.Currencies[0].Currency)
+	assert.Equal(t, int64(10), resp.Currencies[0].Count)
 }
 
 func TestAnalyticsHandler_Get_MissingFrom(t *testing.T) {
@@ -94,7 +99,7 @@ func TestAnalyticsHandler_Get_ValidationError(t *testing.T) {
 	router := setupAnalyticsRouter(h)
 
 	valErr := fmt.Errorf("validate analytics filter: %w", domain.ErrInvalidType)
-	svc.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(domain.AnalyticsResult{}, valErr)
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(domain.AnalyticsReport{}, valErr)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/analytics?from=2024-01-01&to=2024-12-31&type=bad", nil)
 	w := httptest.NewRecorder()
@@ -109,7 +114,7 @@ func TestAnalyticsHandler_Get_ServiceError(t *testing.T) {
 	h := NewAnalyticsHandler(svc, newTestLogger(t))
 	router := setupAnalyticsRouter(h)
 
-	svc.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(domain.AnalyticsResult{}, fmt.Errorf("db error"))
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.Anything).Return(domain.AnalyticsReport{}, fmt.Errorf("db error"))
 
 	req := httptest.NewRequest(http.MethodGet, "/api/analytics?from=2024-01-01&to=2024-12-31", nil)
 	w := httptest.NewRecorder()
@@ -118,3 +123,180 @@ func TestAnalyticsHandler_Get_ServiceError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
+
+func TestAnalyticsHandler_Get_BaseCurrency(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.BaseCurrency == "RUB"
+	})).Return(domain.AnalyticsReport{BaseCurrency: "RUB"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/analytics?from=2024-01-01&to=2024-12-31&base_currency=rub", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAnalyticsHandler_Get_FieldFilters(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.MinAmount.String() == "1000" && f.MaxAmount == nil &&
+			assert.ObjectsAreEqual([]string{"Rent"}, f.ExcludeCategories)
+	})).Return(domain.AnalyticsReport{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/analytics?from=2024-01-01&to=2024-12-31&min_amount=1000&exclude_category=Rent", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAnalyticsHandler_Get_Compare(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.Compare == domain.ComparePreviousYear
+	})).Return(domain.AnalyticsReport{Comparison: &domain.AnalyticsComparison{Compare: domain.ComparePreviousYear}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/analytics?from=2024-03-01&to=2024-03-31&compare=previous_year", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"compare":"previous_year"`)
+}
+
+func TestAnalyticsHandler_Cashflow_Success(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetCashflow(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.GroupBy == domain.GroupByWeek && f.IncludeTransfers && f.Fill == domain.FillZero
+	})).Return(domain.CashflowReport{
+		GroupBy: domain.GroupByWeek,
+		Currencies: []domain.CashflowResult{{
+			Currency: "RUB",
+			Buckets:  []domain.CashflowBucket{{Key: "2024-01-01", Currency: "RUB"}},
+		}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/analytics/cashflow?from=2024-01-01&to=2024-03-31&group_by=week&include_transfers=true&fill=zero", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"cumulative_net"`)
+}
+
+func TestAnalyticsHandler_Cashflow_ValidationError(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	valErr := fmt.Errorf("validate cashflow filter: %w", domain.ErrInvalidCashflowGroupBy)
+	svc.EXPECT().GetCashflow(mock.Anything, mock.Anything).Return(domain.CashflowReport{}, valErr)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/analytics/cashflow?from=2024-01-01&to=2024-03-31&group_by=tag", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAnalyticsHandler_Get_WeekStartAndTimezone(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.GroupBy == domain.GroupByWeek && f.WeekStart == "sunday" && f.Timezone == "America/New_York"
+	})).Return(domain.AnalyticsReport{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/analytics?from=2024-01-01&to=2024-03-31&group_by=week&week_start=Sunday&tz=America/New_York", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAnalyticsHandler_Get_Pivot(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.GroupBy == domain.GroupByMonth && f.PivotBy == domain.GroupByCategory
+	})).Return(domain.AnalyticsReport{}, nil)
+
+	// измерение времени становится строками независимо от порядка
+	req := httptest.NewRequest(http.MethodGet, "/api/analytics?from=2024-01-01&to=2024-12-31&group_by=category,+month", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAnalyticsHandler_CSV_Success(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	svc.EXPECT().GetAnalytics(mock.Anything, mock.MatchedBy(func(f domain.AnalyticsFilter) bool {
+		return f.GroupBy == domain.GroupByMonth && f.PivotBy == domain.GroupByType
+	})).Return(domain.AnalyticsReport{Currencies: []domain.AnalyticsResult{{
+		Currency: "RUB",
+		Pivot: &domain.AnalyticsPivot{
+			Rows:    []string{"2024-01-01"},
+			Columns: []string{"expense", "income"},
+			Cells:   [][]domain.PivotCell{{{Count: 3}, {Count: 1}}},
+		},
+	}}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/export/analytics/csv?from=2024-01-01&to=2024-12-31&group_by=month,type&metric=count", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	assert.Equal(t, "currency,month,expense,income\nRUB,2024-01-01,3,1\n", w.Body.String())
+}
+
+func TestAnalyticsHandler_CSV_BadRequest(t *testing.T) {
+	svc := newMockanalyticsService(t)
+	h := NewAnalyticsHandler(svc, newTestLogger(t))
+	router := setupAnalyticsRouter(h)
+
+	for _, query := range []string{
+		"from=2024-01-01&to=2024-12-31&group_by=month",
+		"from=2024-01-01&to=2024-12-31&group_by=month,category&metric=sum",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/export/analytics/csv?"+query, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+type attachmentService interface {
+	Upload(ctx context.Context, itemID, fileName string, size int64, content io.Reader) (domain.Attachment, error)
+	List(ctx context.Context, itemID string) ([]domain.Attachment, error)
+	Open(ctx context.Context, itemID, id string) (domain.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, itemID, id string) error
+}
+
+// multipartOverhead — запас на заголовки и границы multipart поверх размера самого файла.
+const multipartOverhead = 1 << 20
+
+type AttachmentHandler struct {
+	svc           attachmentService
+	maxUploadSize int64
+	log           logger.Logger
+}
+
+func NewAttachmentHandler(svc attachmentService, maxUploadSize int64, log logger.Logger) *AttachmentHandler {
+	return &AttachmentHandler{
+		svc:           svc,
+		maxUploadSize: maxUploadSize,
+		log:           log,
+	}
+}
+
+// Upload - POST /api/items/:id/attachments (multipart, поле file).
+func (h *AttachmentHandler) Upload(c *ginext.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize+multipartOverhead)
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			respondError(c, http.StatusRequestEntityTooLarge, domain.ErrAttachmentTooLarge.Error())
+			return
+		}
+		respondError(c, http.StatusBadRequest, "multipart field 'file' is required")
+		return
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "open uploaded file",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	defer f.Close()
+
+	created, err := h.svc.Upload(c.Request.Context(), c.Param("id"), fh.Filename, fh.Size, f)
+	if err != nil {
+		h.respondAttachmentError(c, err, "upload attachment")
+		return
+	}
+
+	respondJSON(c, http.StatusCreated, created)
+}
+
+// List - GET /api/items/:id/attachments.
+func (h *AttachmentHandler) List(c *ginext.Context) {
+	attachments, err := h.svc.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondAttachmentError(c, err, "list attachments")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, map[string]interface{}{"attachments": attachments})
+}
+
+// Download - GET /api/items/:id/attachments/:attachment_id.
+func (h *AttachmentHandler) Download(c *ginext.Context) {
+	a, content, err := h.svc.Open(c.Request.Context(), c.Param("id"), c.Param("attachment_id"))
+	if err != nil {
+		h.respondAttachmentError(c, err, "download attachment")
+		return
+	}
+	defer content.Close()
+
+	c.Header("Content-Type", a.MimeType)
+	c.Header("Content-Length", strconv.FormatInt(a.Size, 10))
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.FileName}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	if _, err = io.Copy(c.Writer, content); err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "write attachment",
+			logger.String("error", err.Error()))
+	}
+}
+
+// Delete - DELETE /api/items/:id/attachments/:attachment_id.
+func (h *AttachmentHandler) Delete(c *ginext.Context) {
+	err := h.svc.Delete(c.Request.Context(), c.Param("id"), c.Param("attachment_id"))
+	if errors.Is(err, domain.ErrAttachmentCleanup) {
+		// вложение удалено, в хранилище остался только файл
+		h.log.LogAttrs(c.Request.Context(), logger.WarnLevel, "delete attachment file",
+			logger.String("error", err.Error()))
+		err = nil
+	}
+	if err != nil {
+		h.respondAttachmentError(c, err, "delete attachment")
+		return
+	}
+
+	respondNoContent(c)
+}
+
+func (h *AttachmentHandler) respondAttachmentError(c *ginext.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrItemNotFound):
+		respondError(c, http.StatusNotFound, "item not found")
+	case errors.Is(err, domain.ErrAttachmentNotFound):
+		respondError(c, http.StatusNotFound, "attachment not found")
+	case errors.Is(err, domain.ErrAttachmentTooLarge):
+		respondError(c, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, domain.ErrAttachmentType):
+		respondError(c, http.StatusUnsupportedMediaType, err.Error())
+	case domain.IsValidationError(err):
+		respondError(c, http.StatusBadRequest, err.Error())
+	default:
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, msg,
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAttachmentRouter(h *AttachmentHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/items/:id/attachments", gin.HandlerFunc(h.Upload))
+	r.GET("/api/items/:id/attachments", gin.HandlerFunc(h.List))
+	r.GET("/api/items/:id/attachments/:attachment_id", gin.HandlerFunc(h.Download))
+	r.DELETE("/api/items/:id/attachments/:attachment_id", gin.HandlerFunc(h.Delete))
+	return r
+}
+
+func newUploadRequest(t *testing.T, field, name, content string) *http.Request {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile(field, name)
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/items/"+testItemID()+"/attachments", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func TestAttachmentHandler_Upload_Success(t *testing.T) {
+	svc := newMockattachmentService(t)
+	h := NewAttachmentHandler(svc, 1024, newTestLogger(t))
+	router := setupAttachmentRouter(h)
+
+	svc.EXPECT().Upload(mock.Anything, testItemID(), "receipt.pdf", int64(8), mock.Anything).
+		Return(domain.Attachment{ID: testItemID(), MimeType: "application/pdf"}, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newUploadRequest(t, "file", "receipt.pdf", "%PDF-1.4"))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestAttachmentHandler_Upload_MissingFile(t *testing.T) {
+	svc := newMockattachmentService(t)
+	h := NewAttachmentHandler(svc, 1024, newTestLogger(t))
+	router := setupAttachmentRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newUploadRequest(t, "other", "receipt.pdf", "%PDF-1.4"))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAttachmentHandler_Upload_UnsupportedType(t *testing.T) {
+	svc := newMockattachmentService(t)
+	h := NewAttachmentHandler(svc, 1024, newTestLogger(t))
+	router := setupAttachmentRouter(h)
+
+	svc.EXPECT().Upload(mock.Anything, testItemID(), "notes.txt", int64(5), mock.Anything).
+		Return(domain.Attachment{}, domain.ErrAttachmentType)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newUploadRequest(t, "file", "notes.txt", "hello"))
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func TestAttachmentHandler_Download(t *testing.T) {
+	svc := newMockattachmentService(t)
+	h := NewAttachmentHandler(svc, 1024, newTestLogger(t))
+	router := setupAttachmentRouter(h)
+
+	a := domain.Attachment{ID: testItemID(), FileName: "чек.pdf", MimeType: "application/pdf", Size: 8}
+	svc.EXPECT().Open(mock.Anything, testItemID(), testItemID()).
+		Return(a, io.NopCloser(strings.NewReader("%PDF-1.4")), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items/"+testItemID()+"/attachments/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment; filename*=utf-8''")
+	assert.Equal(t, "%PDF-1.4", w.Body.String())
+}
+
+func TestAttachmentHandler_Delete_CleanupErrorIsNoContent(t *testing.T) {
+	svc := newMockattachmentService(t)
+	h := NewAttachmentHandler(svc, 1024, newTestLogger(t))
+	router := setupAttachmentRouter(h)
+
+	svc.EXPECT().Delete(mock.Anything, testItemID(), testItemID()).Return(domain.ErrAttachmentCleanup)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/items/"+testItemID()+"/attachments/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
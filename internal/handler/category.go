@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+type categoryService interface {
+	Create(ctx context.Context, cat domain.Category) (domain.Category, error)
+	List(ctx context.Context, includeArchived bool) ([]domain.Category, error)
+	GetByID(ctx context.Context, id string) (domain.Category, error)
+	Update(ctx context.Context, cat domain.Category) (domain.Category, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type CategoryHandler struct {
+	svc categoryService
+	log logger.Logger
+}
+
+func NewCategoryHandler(svc categoryService, log logger.Logger) *CategoryHandler {
+	return &CategoryHandler{
+		svc: svc,
+		log: log,
+	}
+}
+
+// Create - POST /api/categories.
+func (h *CategoryHandler) Create(c *ginext.Context) {
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	created, err := h.svc.Create(c.Request.Context(), req.ToCategory(""))
+	if err != nil {
+		h.respondCategoryError(c, err, "create category")
+		return
+	}
+
+	respondJSON(c, http.StatusCreated, created)
+}
+
+// List - GET /api/categories?include_archived=true.
+func (h *CategoryHandler) List(c *ginext.Context) {
+	includeArchived := false
+	if v := c.Query("include_archived"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "invalid 'include_archived' parameter")
+			return
+		}
+		includeArchived = b
+	}
+
+	cats, err := h.svc.List(c.Request.Context(), includeArchived)
+	if err != nil {
+		h.respondCategoryError(c, err, "list categories")
+		return
+	}
+
+	if cats == nil {
+		cats = []domain.Category{}
+	}
+	respondJSON(c, http.StatusOK, map[string]interface{}{"categories": cats})
+}
+
+// GetByID - GET /api/categories/:id.
+func (h *CategoryHandler) GetByID(c *ginext.Context) {
+	cat, err := h.svc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondCategoryError(c, err, "get category by id")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, cat)
+}
+
+// Update - PUT /api/categories/:id.
+func (h *CategoryHandler) Update(c *ginext.Context) {
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated, err := h.svc.Update(c.Request.Context(), req.ToCategory(c.Param("id")))
+	if err != nil {
+		h.respondCategoryError(c, err, "update category")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, updated)
+}
+
+// Delete - DELETE /api/categories/:id.
+func (h *CategoryHandler) Delete(c *ginext.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondCategoryError(c, err, "delete category")
+		return
+	}
+
+	respondNoContent(c)
+}
+
+func (h *CategoryHandler) respondCategoryError(c *ginext.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		respondError(c, http.StatusNotFound, "category not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		respondError(c, http.StatusBadRequest, "invalid category id")
+	case errors.Is(err, domain.ErrCategoryExists), errors.Is(err, domain.ErrCategoryInUse):
+		respondError(c, http.StatusConflict, err.Error())
+	case domain.IsValidationError(err):
+		respondError(c, http.StatusBadRequest, err.Error())
+	default:
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, msg,
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+	}
+}
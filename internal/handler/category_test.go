@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupCategoryRouter(h *CategoryHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/categories", gin.HandlerFunc(h.Create))
+	r.GET("/api/categories", gin.HandlerFunc(h.List))
+	r.GET("/api/categories/:id", gin.HandlerFunc(h.GetByID))
+	r.PUT("/api/categories/:id", gin.HandlerFunc(h.Update))
+	r.DELETE("/api/categories/:id", gin.HandlerFunc(h.Delete))
+	return r
+}
+
+func testCategory() domain.Category {
+	return domain.Category{ID: testItemID(), Name: "food", Type: domain.TypeExpense}
+}
+
+func TestCategoryHandler_Create_Success(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.MatchedBy(func(c domain.Category) bool {
+		return c.Name == "food" && c.Type == domain.TypeExpense && c.ParentID == nil
+	})).Return(testCategory(), nil)
+
+	body := `{"name":"food","type":"expense"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/categories", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestCategoryHandler_Create_InvalidParentID(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	body := `{"name":"food","parent_id":"nope"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/categories", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "ParentID must be a valid UUID")
+}
+
+func TestCategoryHandler_Create_Exists(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.Anything).Return(domain.Category{}, domain.ErrCategoryExists)
+
+	body := `{"name":"Food"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/categories", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestCategoryHandler_List_IncludeArchived(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	svc.EXPECT().List(mock.Anything, true).Return(nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/categories?include_archived=true", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"categories":[]}`, w.Body.String())
+}
+
+func TestCategoryHandler_Update_Cycle(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	svc.EXPECT().Update(mock.Anything, mock.Anything).Return(domain.Category{}, domain.ErrCategoryCycle)
+
+	body := `{"name":"food","parent_id":"` + testItemID() + `"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/categories/"+testItemID(), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCategoryHandler_Delete_InUse(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	svc.EXPECT().Delete(mock.Anything, testItemID()).Return(domain.ErrCategoryInUse)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/categories/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestCategoryHandler_GetByID_NotFound(t *testing.T) {
+	svc := newMockcategoryService(t)
+	h := NewCategoryHandler(svc, newTestLogger(t))
+	router := setupCategoryRouter(h)
+
+	svc.EXPECT().GetByID(mock.Anything, testItemID()).Return(domain.Category{}, domain.ErrCategoryNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/categories/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
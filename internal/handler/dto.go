@@ -1,12 +1,15 @@
 package handler
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/shopspring/decimal"
 	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stpnv0/SalesTracker/internal/importer"
 )
 
 var validate = validator.New()
@@ -29,6 +32,12 @@ func formatValidationErrors(err error) error {
 			return fmt.Errorf("%w: %s must be a valid date in format %s", domain.ErrValidation, fe.Field(), fe.Param())
 		case "max":
 			return fmt.Errorf("%w: %s must be at most %s characters", domain.ErrValidation, fe.Field(), fe.Param())
+		case "iso4217":
+			return fmt.Errorf("%w: %s must be a valid ISO 4217 currency code", domain.ErrValidation, fe.Field())
+		case "uuid":
+			return fmt.Errorf("%w: %s must be a valid UUID", domain.ErrValidation, fe.Field())
+		case "nefield":
+			return fmt.Errorf("%w: %s must differ from %s", domain.ErrValidation, fe.Field(), fe.Param())
 		default:
 			return fmt.Errorf("%w: %s failed on '%s' check", domain.ErrValidation, fe.Field(), fe.Tag())
 		}
@@ -38,11 +47,15 @@ func formatValidationErrors(err error) error {
 }
 
 type CreateItemRequest struct {
-	Type        string          `json:"type"        validate:"required,oneof=income expense"`
-	Amount      decimal.Decimal `json:"amount"`
-	Category    string          `json:"category"    validate:"required,max=100"`
-	Description string          `json:"description" validate:"max=1000"`
-	Date        string          `json:"date"        validate:"required,datetime=2006-01-02"`
+	Type        string             `json:"type"        validate:"required,oneof=income expense"`
+	Amount      decimal.Decimal    `json:"amount"`
+	Currency    string             `json:"currency"    validate:"omitempty,iso4217"`
+	Category    string             `json:"category"    validate:"required,max=100"`
+	Description string             `json:"description" validate:"max=1000"`
+	Tags        []string           `json:"tags"        validate:"dive,required,max=50"`
+	Splits      []ItemSplitRequest `json:"splits"`
+	AccountID   string             `json:"account_id"  validate:"omitempty,uuid"`
+	Date        string             `json:"date"        validate:"required,datetime=2006-01-02"`
 }
 
 func (r CreateItemRequest) Validate() error {
@@ -52,7 +65,10 @@ func (r CreateItemRequest) Validate() error {
 	if !r.Amount.IsPositive() {
 		return fmt.Errorf("%w: Amount must be greater than 0", domain.ErrValidation)
 	}
-	return nil
+	if err := validateTagCount(r.Tags); err != nil {
+		return err
+	}
+	return validateSplits(r.Splits)
 }
 
 func (r CreateItemRequest) ToItem() (domain.Item, error) {
@@ -65,20 +81,45 @@ func (r CreateItemRequest) ToItem() (domain.Item, error) {
 	return domain.Item{
 		Type:        r.Type,
 		Amount:      r.Amount,
+		Currency:    currencyOrDefault(r.Currency),
 		Category:    r.Category,
 		Description: r.Description,
+		Tags:        domain.NormalizeTags(r.Tags),
+		Splits:      toSplits(r.Splits),
+		AccountID:   optionalID(r.AccountID),
 		Date:        date,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}, nil
 }
 
+// createRequestFromRecord собирает CreateItemRequest из строки CSV-импорта; id из файла не используется.
+func createRequestFromRecord(rec importer.ItemRecord) (CreateItemRequest, error) {
+	amount, err := decimal.NewFromString(rec.Amount)
+	if err != nil {
+		return CreateItemRequest{}, fmt.Errorf("%w: Amount must be a decimal number", domain.ErrValidation)
+	}
+
+	return CreateItemRequest{
+		Type:        rec.Type,
+		Amount:      amount,
+		Currency:    rec.Currency,
+		Category:    rec.Category,
+		Description: rec.Description,
+		Date:        rec.Date,
+	}, nil
+}
+
 type UpdateItemRequest struct {
-	Type        string          `json:"type"        validate:"required,oneof=income expense"`
-	Amount      decimal.Decimal `json:"amount"`
-	Category    string          `json:"category"    validate:"required,max=100"`
-	Description string          `json:"description" validate:"max=1000"`
-	Date        string          `json:"date"        validate:"required,datetime=2006-01-02"`
+	Type        string             `json:"type"        validate:"required,oneof=income expense"`
+	Amount      decimal.Decimal    `json:"amount"`
+	Currency    string             `json:"currency"    validate:"omitempty,iso4217"`
+	Category    string             `json:"category"    validate:"required,max=100"`
+	Description string             `json:"description" validate:"max=1000"`
+	Tags        []string           `json:"tags"        validate:"dive,required,max=50"`
+	Splits      []ItemSplitRequest `json:"splits"`
+	AccountID   string             `json:"account_id"  validate:"omitempty,uuid"`
+	Date        string             `json:"date"        validate:"required,datetime=2006-01-02"`
 }
 
 func (r UpdateItemRequest) Validate() error {
@@ -88,9 +129,13 @@ func (r UpdateItemRequest) Validate() error {
 	if !r.Amount.IsPositive() {
 		return fmt.Errorf("%w: Amount must be greater than 0", domain.ErrValidation)
 	}
-	return nil
+	if err := validateTagCount(r.Tags); err != nil {
+		return err
+	}
+	return validateSplits(r.Splits)
 }
 
+// ToItem оставляет Currency пустой, если она не передана: валюта записи при этом не меняется.
 func (r UpdateItemRequest) ToItem(id string) (domain.Item, error) {
 	date, err := time.Parse("2006-01-02", r.Date)
 	if err != nil {
@@ -101,9 +146,439 @@ func (r UpdateItemRequest) ToItem(id string) (domain.Item, error) {
 		ID:          id,
 		Type:        r.Type,
 		Amount:      r.Amount,
+		Currency:    r.Currency,
 		Category:    r.Category,
 		Description: r.Description,
+		Tags:        domain.NormalizeTags(r.Tags),
+		Splits:      toSplits(r.Splits),
+		AccountID:   optionalID(r.AccountID),
 		Date:        date,
 		UpdatedAt:   time.Now().UTC(),
 	}, nil
 }
+
+// patchItemFields — поля UpdateItemRequest, доступные для PATCH, в порядке json-ключей.
+var patchItemFields = []struct {
+	key      string
+	field    string
+	nullable bool // null сбрасывает поле к значению по умолчанию
+}{
+	{key: "type", field: "Type"},
+	{key: "amount", field: "Amount"},
+	{key: "currency", field: "Currency", nullable: true},
+	{key: "category", field: "Category"},
+	{key: "description", field: "Description", nullable: true},
+	{key: "tags", field: "Tags", nullable: true},
+	{key: "splits", field: "Splits", nullable: true},
+	{key: "account_id", field: "AccountID", nullable: true},
+	{key: "date", field: "Date"},
+}
+
+// PatchItemRequest — тело PATCH /api/items/:id по JSON Merge Patch (RFC 7396):
+// отсутствующие поля не меняются, null сбрасывает необязательные поля.
+type PatchItemRequest struct {
+	UpdateItemRequest
+	provided []string // имена полей UpdateItemRequest, присутствующих в теле
+}
+
+func ParsePatchItemRequest(body []byte) (PatchItemRequest, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil || raw == nil {
+		return PatchItemRequest{}, fmt.Errorf("%w: merge patch must be a JSON object", domain.ErrValidation)
+	}
+
+	var req PatchItemRequest
+	if err := json.Unmarshal(body, &req.UpdateItemRequest); err != nil {
+		return PatchItemRequest{}, fmt.Errorf("%w: %s", domain.ErrValidation, err.Error())
+	}
+
+	for _, f := range patchItemFields {
+		v, ok := raw[f.key]
+		if !ok {
+			continue
+		}
+		if bytes.Equal(bytes.TrimSpace(v), []byte("null")) && !f.nullable {
+			return PatchItemRequest{}, fmt.Errorf("%w: %s cannot be null", domain.ErrValidation, f.field)
+		}
+		req.provided = append(req.provided, f.field)
+	}
+
+	return req, nil
+}
+
+func (r PatchItemRequest) has(field string) bool {
+	for _, f := range r.provided {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate проверяет только переданные поля теми же правилами, что и PUT.
+func (r PatchItemRequest) Validate() error {
+	if len(r.provided) == 0 {
+		return nil
+	}
+	if err := validate.StructPartial(r.UpdateItemRequest, r.provided...); err != nil {
+		return formatValidationErrors(err)
+	}
+	if r.has("Amount") && !r.Amount.IsPositive() {
+		return fmt.Errorf("%w: Amount must be greater than 0", domain.ErrValidation)
+	}
+	if err := validateTagCount(r.Tags); err != nil {
+		return err
+	}
+	return validateSplits(r.Splits)
+}
+
+func (r PatchItemRequest) ToPatch(id string) (domain.ItemPatch, error) {
+	patch := domain.ItemPatch{
+		ID:        id,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if r.has("Type") {
+		patch.Type = &r.Type
+	}
+	if r.has("Amount") {
+		patch.Amount = &r.Amount
+	}
+	if r.has("Currency") {
+		currency := currencyOrDefault(r.Currency)
+		patch.Currency = &currency
+	}
+	if r.has("Category") {
+		patch.Category = &r.Category
+	}
+	if r.has("Description") {
+		patch.Description = &r.Description
+	}
+	if r.has("Tags") {
+		tags := domain.NormalizeTags(r.Tags)
+		patch.Tags = &tags
+	}
+	if r.has("Splits") {
+		splits := toSplits(r.Splits)
+		patch.Splits = &splits
+	}
+	if r.has("AccountID") {
+		patch.AccountID = &r.AccountID
+	}
+	if r.has("Date") {
+		date, err := time.Parse("2006-01-02", r.Date)
+		if err != nil {
+			return domain.ItemPatch{}, fmt.Errorf("%w: invalid date format", domain.ErrValidation)
+		}
+		patch.Date = &date
+	}
+
+	return patch, nil
+}
+
+// ItemSplitRequest — строка разбивки записи; сумма строк сверяется с суммой записи в сервисе.
+type ItemSplitRequest struct {
+	Category string          `json:"category" validate:"required,max=100"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// BatchOperationRequest — строка POST /api/items/batch. item проверяется так же,
+// как тело POST (create) или PUT (update); для update и delete нужен id.
+type BatchOperationRequest struct {
+	Op      string          `json:"op"      validate:"required,oneof=create update delete"`
+	ID      string          `json:"id"`
+	Version int64           `json:"version"` // аналог If-Match для update, 0 — без проверки
+	Item    json.RawMessage `json:"item"`
+}
+
+func (r BatchOperationRequest) ToOperation(index int) (domain.BatchOperation, error) {
+	if err := validate.Struct(r); err != nil {
+		return domain.BatchOperation{}, formatValidationErrors(err)
+	}
+
+	op := domain.BatchOperation{Index: index, Op: r.Op}
+	if r.Op != domain.BatchOpCreate && r.ID == "" {
+		return op, fmt.Errorf("%w: id is required for %s", domain.ErrValidation, r.Op)
+	}
+	if r.Op != domain.BatchOpDelete && len(r.Item) == 0 {
+		return op, fmt.Errorf("%w: item is required for %s", domain.ErrValidation, r.Op)
+	}
+
+	switch r.Op {
+	case domain.BatchOpCreate:
+		var req CreateItemRequest
+		if err := json.Unmarshal(r.Item, &req); err != nil {
+			return op, fmt.Errorf("%w: invalid item: %s", domain.ErrValidation, err.Error())
+		}
+		if err := req.Validate(); err != nil {
+			return op, err
+		}
+		item, err := req.ToItem()
+		if err != nil {
+			return op, err
+		}
+		op.Item = item
+	case domain.BatchOpUpdate:
+		var req UpdateItemRequest
+		if err := json.Unmarshal(r.Item, &req); err != nil {
+			return op, fmt.Errorf("%w: invalid item: %s", domain.ErrValidation, err.Error())
+		}
+		if err := req.Validate(); err != nil {
+			return op, err
+		}
+		item, err := req.ToItem(r.ID)
+		if err != nil {
+			return op, err
+		}
+		item.Version = r.Version
+		op.Item = item
+	case domain.BatchOpDelete:
+		op.Item.ID = r.ID
+	}
+
+	return op, nil
+}
+
+type RecurringItemRequest struct {
+	Type        string          `json:"type"        validate:"required,oneof=income expense"`
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency"    validate:"omitempty,iso4217"`
+	Category    string          `json:"category"    validate:"required,max=100"`
+	Description string          `json:"description" validate:"max=1000"`
+	Frequency   string          `json:"frequency"   validate:"required,oneof=daily weekly monthly yearly"`
+	Interval    int             `json:"interval"` // 0 — каждый период
+	StartDate   string          `json:"start_date"  validate:"required,datetime=2006-01-02"`
+	EndDate     string          `json:"end_date"    validate:"omitempty,datetime=2006-01-02"`
+}
+
+func (r RecurringItemRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return formatValidationErrors(err)
+	}
+	if !r.Amount.IsPositive() {
+		return fmt.Errorf("%w: Amount must be greater than 0", domain.ErrValidation)
+	}
+	if r.Interval < 0 || r.Interval > 1000 {
+		return fmt.Errorf("%w: Interval must be between 0 and 1000 (0 means 1)", domain.ErrValidation)
+	}
+	return nil
+}
+
+func (r RecurringItemRequest) ToRecurringItem() (domain.RecurringItem, error) {
+	start, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return domain.RecurringItem{}, fmt.Errorf("%w: invalid start_date format", domain.ErrValidation)
+	}
+
+	var end *time.Time
+	if r.EndDate != "" {
+		t, err := time.Parse("2006-01-02", r.EndDate)
+		if err != nil {
+			return domain.RecurringItem{}, fmt.Errorf("%w: invalid end_date format", domain.ErrValidation)
+		}
+		if t.Before(start) {
+			return domain.RecurringItem{}, fmt.Errorf("%w: end_date must not be before start_date", domain.ErrValidation)
+		}
+		end = &t
+	}
+
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	now := time.Now().UTC()
+	return domain.RecurringItem{
+		Type:        r.Type,
+		Amount:      r.Amount,
+		Currency:    currencyOrDefault(r.Currency),
+		Category:    r.Category,
+		Description: r.Description,
+		Frequency:   r.Frequency,
+		Interval:    interval,
+		StartDate:   start,
+		EndDate:     end,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+type CategoryRequest struct {
+	Name     string `json:"name"      validate:"required,max=100"`
+	ParentID string `json:"parent_id" validate:"omitempty,uuid"`
+	Type     string `json:"type"      validate:"omitempty,oneof=income expense"`
+	Archived bool   `json:"archived"`
+}
+
+func (r CategoryRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return formatValidationErrors(err)
+	}
+	return nil
+}
+
+// ToCategory собирает категорию; id пустой при создании.
+func (r CategoryRequest) ToCategory(id string) domain.Category {
+	var parentID *string
+	if r.ParentID != "" {
+		parentID = &r.ParentID
+	}
+
+	now := time.Now().UTC()
+	return domain.Category{
+		ID:        id,
+		Name:      r.Name,
+		ParentID:  parentID,
+		Type:      r.Type,
+		Archived:  r.Archived,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+type AccountRequest struct {
+	Name           string          `json:"name"            validate:"required,max=100"`
+	Currency       string          `json:"currency"        validate:"omitempty,iso4217"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	Closed         bool            `json:"closed"`
+}
+
+func (r AccountRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return formatValidationErrors(err)
+	}
+	return nil
+}
+
+// ToAccount собирает счёт; id пустой при создании.
+func (r AccountRequest) ToAccount(id string) domain.Account {
+	now := time.Now().UTC()
+	return domain.Account{
+		ID:             id,
+		Name:           r.Name,
+		Currency:       currencyOrDefault(r.Currency),
+		OpeningBalance: r.OpeningBalance,
+		Closed:         r.Closed,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+type TransferRequest struct {
+	FromAccountID string           `json:"from_account_id" validate:"required,uuid"`
+	ToAccountID   string           `json:"to_account_id"   validate:"required,uuid,nefield=FromAccountID"`
+	Amount        decimal.Decimal  `json:"amount"`
+	ToAmount      *decimal.Decimal `json:"to_amount"` // только для счетов в разных валютах
+	Description   string           `json:"description"     validate:"max=1000"`
+	Date          string           `json:"date"            validate:"required,datetime=2006-01-02"`
+}
+
+func (r TransferRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return formatValidationErrors(err)
+	}
+	if !r.Amount.IsPositive() {
+		return fmt.Errorf("%w: Amount must be greater than 0", domain.ErrValidation)
+	}
+	if r.ToAmount != nil && !r.ToAmount.IsPositive() {
+		return fmt.Errorf("%w: ToAmount must be greater than 0", domain.ErrValidation)
+	}
+	return nil
+}
+
+func (r TransferRequest) ToTransferParams() (domain.TransferParams, error) {
+	date, err := time.Parse("2006-01-02", r.Date)
+	if err != nil {
+		return domain.TransferParams{}, fmt.Errorf("%w: invalid date format", domain.ErrValidation)
+	}
+
+	return domain.TransferParams{
+		FromAccountID: r.FromAccountID,
+		ToAccountID:   r.ToAccountID,
+		Amount:        r.Amount,
+		ToAmount:      r.ToAmount,
+		Description:   r.Description,
+		Date:          date,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+type ExchangeRateRequest struct {
+	Date string          `json:"date" validate:"required,datetime=2006-01-02"`
+	From string          `json:"from" validate:"required,iso4217"`
+	To   string          `json:"to"   validate:"required,iso4217,nefield=From"`
+	Rate decimal.Decimal `json:"rate"`
+}
+
+func (r ExchangeRateRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return formatValidationErrors(err)
+	}
+	if !r.Rate.IsPositive() {
+		return fmt.Errorf("%w: Rate must be greater than 0", domain.ErrValidation)
+	}
+	return nil
+}
+
+// ToRate собирает курс; id пустой при создании.
+func (r ExchangeRateRequest) ToRate(id string) (domain.ExchangeRate, error) {
+	date, err := time.Parse("2006-01-02", r.Date)
+	if err != nil {
+		return domain.ExchangeRate{}, fmt.Errorf("%w: invalid date format", domain.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	return domain.ExchangeRate{
+		ID:        id,
+		Date:      date,
+		From:      r.From,
+		To:        r.To,
+		Rate:      r.Rate,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func validateTagCount(tags []string) error {
+	if len(tags) > domain.MaxItemTags {
+		return fmt.Errorf("%w: Tags must contain at most %d tags", domain.ErrValidation, domain.MaxItemTags)
+	}
+	return nil
+}
+
+// validateSplits проверяет строки разбивки по отдельности: StructPartial в срезы структур не спускается.
+func validateSplits(splits []ItemSplitRequest) error {
+	if len(splits) > domain.MaxItemSplits {
+		return fmt.Errorf("%w: Splits must contain at most %d lines", domain.ErrValidation, domain.MaxItemSplits)
+	}
+	for _, split := range splits {
+		if err := validate.Struct(split); err != nil {
+			return formatValidationErrors(err)
+		}
+	}
+	return nil
+}
+
+// toSplits никогда не возвращает nil, чтобы в JSON всегда был массив.
+func toSplits(reqs []ItemSplitRequest) []domain.ItemSplit {
+	splits := make([]domain.ItemSplit, 0, len(reqs))
+	for _, r := range reqs {
+		splits = append(splits, domain.ItemSplit{Category: r.Category, Amount: r.Amount})
+	}
+	return splits
+}
+
+// optionalID превращает пустой id в nil.
+func optionalID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+func currencyOrDefault(currency string) string {
+	if currency == "" {
+		return domain.DefaultCurrency
+	}
+	return currency
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -109,6 +111,19 @@ func TestCreateItemRequest_Validate_InvalidDate(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrValidation)
 }
 
+func TestCreateItemRequest_Validate_InvalidCurrency(t *testing.T) {
+	req := CreateItemRequest{
+		Type:     "income",
+		Amount:   decimal.NewFromInt(100),
+		Currency: "XYZ",
+		Category: "salary",
+		Date:     "2024-06-15",
+	}
+	err := req.Validate()
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
 func TestCreateItemRequest_ToItem(t *testing.T) {
 	req := CreateItemRequest{
 		Type:        "expense",
@@ -124,6 +139,7 @@ func TestCreateItemRequest_ToItem(t *testing.T) {
 	assert.True(t, decimal.NewFromFloat(55.50).Equal(item.Amount))
 	assert.Equal(t, "food", item.Category)
 	assert.Equal(t, "lunch", item.Description)
+	assert.Equal(t, domain.DefaultCurrency, item.Currency)
 	assert.Equal(t, 2024, item.Date.Year())
 	assert.Equal(t, 6, int(item.Date.Month()))
 	assert.Equal(t, 15, item.Date.Day())
@@ -131,6 +147,30 @@ func TestCreateItemRequest_ToItem(t *testing.T) {
 	assert.False(t, item.UpdatedAt.IsZero())
 }
 
+func TestCreateItemRequest_Tags(t *testing.T) {
+	req := CreateItemRequest{
+		Type:     "expense",
+		Amount:   decimal.NewFromInt(10),
+		Category: "food",
+		Tags:     []string{" Vacation-2026 ", "reimbursable", "vacation-2026"},
+		Date:     "2024-06-15",
+	}
+	require.NoError(t, req.Validate())
+
+	item, err := req.ToItem()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reimbursable", "vacation-2026"}, item.Tags)
+
+	req.Tags = make([]string, domain.MaxItemTags+1)
+	for i := range req.Tags {
+		req.Tags[i] = fmt.Sprintf("tag-%d", i)
+	}
+	assert.ErrorIs(t, req.Validate(), domain.ErrValidation)
+
+	req.Tags = []string{strings.Repeat("x", 51)}
+	assert.ErrorIs(t, req.Validate(), domain.ErrValidation)
+}
+
 func TestUpdateItemRequest_Validate_Valid(t *testing.T) {
 	req := UpdateItemRequest{
 		Type:        "expense",
@@ -147,6 +187,7 @@ func TestUpdateItemRequest_ToItem(t *testing.T) {
 	req := UpdateItemRequest{
 		Type:        "income",
 		Amount:      decimal.NewFromInt(200),
+		Currency:    "USD",
 		Category:    "freelance",
 		Description: "project",
 		Date:        "2024-08-10",
@@ -158,6 +199,132 @@ func TestUpdateItemRequest_ToItem(t *testing.T) {
 	assert.Equal(t, id, item.ID)
 	assert.Equal(t, "income", item.Type)
 	assert.True(t, decimal.NewFromInt(200).Equal(item.Amount))
+	assert.Equal(t, "USD", item.Currency)
 	assert.Equal(t, "freelance", item.Category)
 	assert.False(t, item.UpdatedAt.IsZero())
 }
+
+func TestPatchItemRequest_OnlyDescription(t *testing.T) {
+	req, err := ParsePatchItemRequest([]byte(`{"description":"fixed"}`))
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+
+	patch, err := req.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.Description)
+	assert.Equal(t, "fixed", *patch.Description)
+	assert.Nil(t, patch.Type)
+	assert.Nil(t, patch.Amount)
+	assert.Nil(t, patch.Category)
+	assert.Nil(t, patch.Date)
+}
+
+func TestPatchItemRequest_Validate_ProvidedFieldsOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"empty category", `{"category":""}`},
+		{"invalid type", `{"type":"gift"}`},
+		{"zero amount", `{"amount":0}`},
+		{"invalid date", `{"date":"20-01-2024"}`},
+		{"invalid currency", `{"currency":"usd"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := ParsePatchItemRequest([]byte(tt.body))
+			require.NoError(t, err)
+			err = req.Validate()
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestPatchItemRequest_Null(t *testing.T) {
+	_, err := ParsePatchItemRequest([]byte(`{"category":null}`))
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	req, err := ParsePatchItemRequest([]byte(`{"description":null,"currency":null}`))
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+
+	patch, err := req.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.Description)
+	assert.Equal(t, "", *patch.Description)
+	require.NotNil(t, patch.Currency)
+	assert.Equal(t, domain.DefaultCurrency, *patch.Currency)
+}
+
+func TestPatchItemRequest_Tags(t *testing.T) {
+	req, err := ParsePatchItemRequest([]byte(`{"tags":["Travel","travel"]}`))
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+
+	patch, err := req.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.Tags)
+	assert.Equal(t, []string{"travel"}, *patch.Tags)
+
+	req, err = ParsePatchItemRequest([]byte(`{"tags":null}`))
+	require.NoError(t, err)
+	patch, err = req.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.Tags)
+	assert.Empty(t, *patch.Tags)
+	assert.False(t, patch.IsEmpty())
+}
+
+func TestPatchItemRequest_Splits(t *testing.T) {
+	req, err := ParsePatchItemRequest([]byte(`{"splits":[{"category":"food","amount":30},{"category":"home","amount":70}]}`))
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+
+	patch, err := req.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.Splits)
+	require.Len(t, *patch.Splits, 2)
+	assert.Equal(t, "home", (*patch.Splits)[1].Category)
+	assert.True(t, (*patch.Splits)[1].Amount.Equal(decimal.NewFromInt(70)))
+
+	req, err = ParsePatchItemRequest([]byte(`{"splits":[{"amount":30}]}`))
+	require.NoError(t, err)
+	assert.ErrorIs(t, req.Validate(), domain.ErrValidation)
+
+	req, err = ParsePatchItemRequest([]byte(`{"splits":null}`))
+	require.NoError(t, err)
+	patch, err = req.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.Splits)
+	assert.Empty(t, *patch.Splits)
+}
+
+func TestItemRequest_AccountID(t *testing.T) {
+	req := CreateItemRequest{
+		Type:      "expense",
+		Amount:    decimal.NewFromInt(10),
+		Category:  "food",
+		AccountID: "not-a-uuid",
+		Date:      "2024-06-15",
+	}
+	assert.ErrorIs(t, req.Validate(), domain.ErrValidation)
+
+	req.AccountID = ""
+	item, err := req.ToItem()
+	require.NoError(t, err)
+	assert.Nil(t, item.AccountID)
+
+	patchReq, err := ParsePatchItemRequest([]byte(`{"account_id":null}`))
+	require.NoError(t, err)
+	require.NoError(t, patchReq.Validate())
+	patch, err := patchReq.ToPatch("550e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	require.NotNil(t, patch.AccountID)
+	assert.Empty(t, *patch.AccountID)
+}
+
+func TestPatchItemRequest_NotAnObject(t *testing.T) {
+	_, err := ParsePatchItemRequest([]byte(`[1,2]`))
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
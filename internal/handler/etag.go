@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match header, expected ETag returned by GET /api/items/:id")
+
+// itemETag строит сильный ETag из версии записи.
+func itemETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+func setItemETag(c *ginext.Context, item domain.Item) {
+	c.Header("ETag", itemETag(item.Version))
+}
+
+// parseIfMatch возвращает версию из заголовка If-Match; 0 — заголовка нет или передан "*".
+func parseIfMatch(c *ginext.Context) (int64, error) {
+	v := strings.TrimSpace(c.GetHeader("If-Match"))
+	if v == "" || v == "*" {
+		return 0, nil
+	}
+
+	v = strings.TrimPrefix(v, "W/")
+	if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+		return 0, errInvalidIfMatch
+	}
+	version, err := strconv.ParseInt(v[1:len(v)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+	return version, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/stpnv0/SalesTracker/internal/domain"
@@ -13,7 +14,7 @@ import (
 )
 
 type exportItemService interface {
-	List(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error)
+	List(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error)
 }
 
 type ExportHandler struct {
@@ -36,8 +37,12 @@ func (h *ExportHandler) CSV(c *ginext.Context) {
 		return
 	}
 
-	items, _, err := h.svc.List(c.Request.Context(), filter)
+	page, err := h.svc.List(c.Request.Context(), filter)
 	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "export csv",
 			logger.String("error", err.Error()))
 		respondError(c, http.StatusInternalServerError, "internal server error")
@@ -47,7 +52,7 @@ func (h *ExportHandler) CSV(c *ginext.Context) {
 	c.Header("Content-Type", "text/csv")
 	c.Header("Content-Disposition", "attachment; filename=items.csv")
 
-	if err := export.WriteCSV(c.Writer, items); err != nil {
+	if err := export.WriteCSV(c.Writer, page.Items); err != nil {
 		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "write csv",
 			logger.String("error", err.Error()))
 	}
@@ -69,11 +74,20 @@ func parseExportFilter(c *ginext.Context) (domain.ItemFilter, error) {
 		}
 		filter.To = &t
 	}
-	filter.Category = c.Query("category")
 	filter.Type = c.Query("type")
+	filter.Tags = parseTagsQuery(c)
+	filter.TagMatch = c.Query("tag_match")
+	filter.Query = strings.TrimSpace(c.Query("q"))
 	filter.SortBy = domain.SortByDate
 	filter.Order = domain.OrderDesc
 	filter.NoLimit = true
+	filter.SkipTotal = true
+
+	fields, err := parseFieldFilter(c)
+	if err != nil {
+		return filter, err
+	}
+	filter.FieldFilter = fields
 
 	return filter, nil
 }
@@ -39,7 +39,7 @@ func TestExportHandler_CSV_Success(t *testing.T) {
 			UpdatedAt:   time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC),
 		},
 	}
-	svc.EXPECT().List(mock.Anything, mock.Anything).Return(items, int64(1), nil)
+	svc.EXPECT().List(mock.Anything, mock.Anything).Return(testPage(items, 1), nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/export/csv", nil)
 	w := httptest.NewRecorder()
@@ -53,7 +53,7 @@ func TestExportHandler_CSV_Success(t *testing.T) {
 	body := w.Body.String()
 	lines := strings.Split(strings.TrimSpace(body), "\n")
 	assert.Len(t, lines, 2)
-	assert.Equal(t, "id,type,amount,category,description,date,created_at,updated_at", lines[0])
+	assert.Equal(t, "id,type,amount,category,description,date,currency,created_at,updated_at", lines[0])
 	assert.Contains(t, lines[1], "id-1")
 }
 
@@ -63,10 +63,13 @@ func TestExportHandler_CSV_WithFilters(t *testing.T) {
 	router := setupExportRouter(h)
 
 	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.ItemFilter) bool {
-		return f.Category == "food" && f.Type == "expense" && f.NoLimit && f.From != nil && f.To != nil
-	})).Return([]domain.Item{}, int64(0), nil)
+		return assert.ObjectsAreEqual([]string{"food", "cafe"}, f.Categories) &&
+			f.Type == "expense" && f.NoLimit && f.From != nil && f.To != nil &&
+			f.MinAmount != nil && f.MinAmount.String() == "100"
+	})).Return(domain.ItemPage{}, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/api/export/csv?from=2024-01-01&to=2024-12-31&category=food&type=expense", nil)
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/export/csv?from=2024-01-01&to=2024-12-31&category=food,cafe&type=expense&min_amount=100", nil)
 	w := httptest.NewRecorder()
 
 	router.ServeHTTP(w, req)
@@ -79,7 +82,7 @@ func TestExportHandler_CSV_ServiceError(t *testing.T) {
 	h := NewExportHandler(svc, newTestLogger(t))
 	router := setupExportRouter(h)
 
-	svc.EXPECT().List(mock.Anything, mock.Anything).Return(nil, int64(0), fmt.Errorf("db error"))
+	svc.EXPECT().List(mock.Anything, mock.Anything).Return(domain.ItemPage{}, fmt.Errorf("db error"))
 
 	req := httptest.NewRequest(http.MethodGet, "/api/export/csv", nil)
 	w := httptest.NewRecorder()
@@ -89,12 +92,28 @@ func TestExportHandler_CSV_ServiceError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
+func TestExportHandler_CSV_ValidationError(t *testing.T) {
+	svc := newMockexportItemService(t)
+	h := NewExportHandler(svc, newTestLogger(t))
+	router := setupExportRouter(h)
+
+	svc.EXPECT().List(mock.Anything, mock.Anything).
+		Return(domain.ItemPage{}, fmt.Errorf("validate filter: %w", domain.ErrInvalidAmountRange))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/export/csv?min_amount=500&max_amount=100", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestExportHandler_CSV_EmptyResult(t *testing.T) {
 	svc := newMockexportItemService(t)
 	h := NewExportHandler(svc, newTestLogger(t))
 	router := setupExportRouter(h)
 
-	svc.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Item{}, int64(0), nil)
+	svc.EXPECT().List(mock.Anything, mock.Anything).Return(domain.ItemPage{}, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/export/csv", nil)
 	w := httptest.NewRecorder()
@@ -106,5 +125,5 @@ func TestExportHandler_CSV_EmptyResult(t *testing.T) {
 	body := w.Body.String()
 	lines := strings.Split(strings.TrimSpace(body), "\n")
 	assert.Len(t, lines, 1) // header only
-	assert.Equal(t, "id,type,amount,category,description,date,created_at,updated_at", lines[0])
+	assert.Equal(t, "id,type,amount,category,description,date,currency,created_at,updated_at", lines[0])
 }
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stpnv0/SalesTracker/internal/importer"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+type importItemService interface {
+	CheckCategories(ctx context.Context, items []domain.Item) ([]error, error)
+	Import(ctx context.Context, items []domain.Item) ([]domain.Item, error)
+}
+
+type ImportHandler struct {
+	svc importItemService
+	log logger.Logger
+}
+
+func NewImportHandler(svc importItemService, log logger.Logger) *ImportHandler {
+	return &ImportHandler{
+		svc: svc,
+		log: log,
+	}
+}
+
+type importRowResult struct {
+	Line  int          `json:"line"`
+	Item  *domain.Item `json:"item,omitempty"`
+	Error string       `json:"error,omitempty"`
+}
+
+// CSV - POST /api/import/csv?dry_run=true|false.
+func (h *ImportHandler) CSV(c *ginext.Context) {
+	dryRun := false
+	if v := c.Query("dry_run"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "invalid 'dry_run' parameter")
+			return
+		}
+		dryRun = b
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "multipart field 'file' is required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "cannot read uploaded file")
+		return
+	}
+	defer file.Close()
+
+	records, err := importer.ParseItemsCSV(file)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rejected := make([]importRowResult, 0)
+	candidates := make([]domain.Item, 0, len(records))
+	lines := make([]int, 0, len(records))
+	for _, rec := range records {
+		item, err := recordToItem(rec)
+		if err != nil {
+			rejected = append(rejected, importRowResult{Line: rec.Line, Error: err.Error()})
+			continue
+		}
+		candidates = append(candidates, item)
+		lines = append(lines, rec.Line)
+	}
+
+	categoryErrs, err := h.svc.CheckCategories(c.Request.Context(), candidates)
+	if err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "check import categories",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	accepted := make([]importRowResult, 0, len(candidates))
+	items := make([]domain.Item, 0, len(candidates))
+	for i, item := range candidates {
+		if categoryErrs[i] != nil {
+			rejected = append(rejected, importRowResult{Line: lines[i], Error: categoryErrs[i].Error()})
+			continue
+		}
+		items = append(items, item)
+		accepted = append(accepted, importRowResult{Line: lines[i]})
+	}
+	sort.Slice(rejected, func(i, j int) bool { return rejected[i].Line < rejected[j].Line })
+
+	if !dryRun && len(items) > 0 {
+		items, err = h.svc.Import(c.Request.Context(), items)
+		if err != nil {
+			h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "import items csv",
+				logger.String("error", err.Error()))
+			respondError(c, http.StatusInternalServerError, "internal server error")
+			return
+		}
+	}
+	for i := range accepted {
+		accepted[i].Item = &items[i]
+	}
+
+	respondJSON(c, http.StatusOK, map[string]interface{}{
+		"dry_run":  dryRun,
+		"accepted": accepted,
+		"rejected": rejected,
+	})
+}
+
+func recordToItem(rec importer.ItemRecord) (domain.Item, error) {
+	if rec.Err != nil {
+		return domain.Item{}, rec.Err
+	}
+
+	req, err := createRequestFromRecord(rec)
+	if err != nil {
+		return domain.Item{}, err
+	}
+	if err = req.Validate(); err != nil {
+		return domain.Item{}, err
+	}
+	return req.ToItem()
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupImportRouter(h *ImportHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/import/csv", gin.HandlerFunc(h.CSV))
+	return r
+}
+
+type importReport struct {
+	DryRun   bool              `json:"dry_run"`
+	Accepted []importRowResult `json:"accepted"`
+	Rejected []importRowResult `json:"rejected"`
+}
+
+const importCSV = "id,type,amount,category,description,date,currency,created_at,updated_at\n" +
+	",income,100,salary,june,2024-06-15,USD,,\n" +
+	",gift,100,salary,,2024-06-15,,,\n" +
+	",expense,abc,food,,2024-06-16,,,\n" +
+	",expense,25.5,food,,2024-06-16,,,\n"
+
+func TestImportHandler_CSV_Success(t *testing.T) {
+	svc := newMockimportItemService(t)
+	h := NewImportHandler(svc, newTestLogger(t))
+	router := setupImportRouter(h)
+
+	svc.EXPECT().CheckCategories(mock.Anything, mock.Anything).Return(make([]error, 2), nil)
+	svc.EXPECT().Import(mock.Anything, mock.MatchedBy(func(items []domain.Item) bool {
+		return len(items) == 2 && items[0].Currency == "USD" && items[1].Currency == domain.DefaultCurrency
+	})).RunAndReturn(func(_ context.Context, items []domain.Item) ([]domain.Item, error) {
+		for i := range items {
+			items[i].ID = testItemID()
+		}
+		return items, nil
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newCSVUpload(t, "/api/import/csv", importCSV))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp importReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.False(t, resp.DryRun)
+	require.Len(t, resp.Accepted, 2)
+	assert.Equal(t, 2, resp.Accepted[0].Line)
+	assert.Equal(t, testItemID(), resp.Accepted[0].Item.ID)
+	assert.Equal(t, 5, resp.Accepted[1].Line)
+	require.Len(t, resp.Rejected, 2)
+	assert.Equal(t, 3, resp.Rejected[0].Line)
+	assert.Contains(t, resp.Rejected[0].Error, "Type")
+	assert.Equal(t, 4, resp.Rejected[1].Line)
+}
+
+func TestImportHandler_CSV_DryRun(t *testing.T) {
+	svc := newMockimportItemService(t)
+	h := NewImportHandler(svc, newTestLogger(t))
+	router := setupImportRouter(h)
+
+	svc.EXPECT().CheckCategories(mock.Anything, mock.Anything).Return(make([]error, 2), nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newCSVUpload(t, "/api/import/csv?dry_run=true", importCSV))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp importReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.DryRun)
+	assert.Len(t, resp.Accepted, 2)
+	assert.Empty(t, resp.Accepted[0].Item.ID)
+	assert.Len(t, resp.Rejected, 2)
+}
+
+func TestImportHandler_CSV_UnknownCategoryRejected(t *testing.T) {
+	svc := newMockimportItemService(t)
+	h := NewImportHandler(svc, newTestLogger(t))
+	router := setupImportRouter(h)
+
+	svc.EXPECT().CheckCategories(mock.Anything, mock.Anything).
+		Return([]error{nil, fmt.Errorf("%w: %q", domain.ErrUnknownCategory, "food")}, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newCSVUpload(t, "/api/import/csv?dry_run=true", importCSV))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp importReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp.Accepted, 1)
+	assert.Equal(t, 2, resp.Accepted[0].Line)
+	require.Len(t, resp.Rejected, 3)
+	assert.Equal(t, []int{3, 4, 5}, []int{resp.Rejected[0].Line, resp.Rejected[1].Line, resp.Rejected[2].Line})
+	assert.Contains(t, resp.Rejected[2].Error, "category does not exist")
+}
+
+func TestImportHandler_CSV_BadHeader(t *testing.T) {
+	svc := newMockimportItemService(t)
+	h := NewImportHandler(svc, newTestLogger(t))
+	router := setupImportRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newCSVUpload(t, "/api/import/csv", "date,amount\n"))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestImportHandler_CSV_MissingFile(t *testing.T) {
+	svc := newMockimportItemService(t)
+	h := NewImportHandler(svc, newTestLogger(t))
+	router := setupImportRouter(h)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/import/csv", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	"github.com/wb-go/wbf/ginext"
 	"github.com/wb-go/wbf/logger"
@@ -15,9 +17,14 @@ import (
 type itemService interface {
 	Create(ctx context.Context, item domain.Item) (domain.Item, error)
 	GetByID(ctx context.Context, id string) (domain.Item, error)
-	List(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error)
+	List(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error)
 	Update(ctx context.Context, item domain.Item) (domain.Item, error)
+	Patch(ctx context.Context, patch domain.ItemPatch) (domain.Item, error)
 	Delete(ctx context.Context, id string) error
+	Batch(ctx context.Context, ops []domain.BatchOperation, atomic bool) ([]domain.BatchResult, error)
+	Restore(ctx context.Context, id string) (domain.Item, error)
+	Purge(ctx context.Context, olderThanDays int) (int64, error)
+	History(ctx context.Context, id string) ([]domain.ItemHistoryEntry, error)
 }
 
 type ItemHandler struct {
@@ -53,12 +60,17 @@ func (h *ItemHandler) Create(c *ginext.Context) {
 
 	created, err := h.svc.Create(c.Request.Context(), item)
 	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "create item",
 			logger.String("error", err.Error()))
 		respondError(c, http.StatusInternalServerError, "internal server error")
 		return
 	}
 
+	setItemETag(c, created)
 	respondJSON(c, http.StatusCreated, created)
 }
 
@@ -70,7 +82,7 @@ func (h *ItemHandler) List(c *ginext.Context) {
 		return
 	}
 
-	items, totalCount, err := h.svc.List(c.Request.Context(), filter)
+	page, err := h.svc.List(c.Request.Context(), filter)
 	if err != nil {
 		if domain.IsValidationError(err) {
 			respondError(c, http.StatusBadRequest, err.Error())
@@ -82,14 +94,7 @@ func (h *ItemHandler) List(c *ginext.Context) {
 		return
 	}
 
-	if items == nil {
-		items = []domain.Item{}
-	}
-	response := map[string]interface{}{
-		"items":       items,
-		"total_count": totalCount,
-	}
-	respondJSON(c, http.StatusOK, response)
+	respondJSON(c, http.StatusOK, pageResponse(page))
 }
 
 // GetByID — GET /api/items/:id.
@@ -112,6 +117,7 @@ func (h *ItemHandler) GetByID(c *ginext.Context) {
 		return
 	}
 
+	setItemETag(c, item)
 	respondJSON(c, http.StatusOK, item)
 }
 
@@ -119,6 +125,12 @@ func (h *ItemHandler) GetByID(c *ginext.Context) {
 func (h *ItemHandler) Update(c *ginext.Context) {
 	id := c.Param("id")
 
+	version, err := parseIfMatch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	var req UpdateItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
@@ -135,6 +147,7 @@ func (h *ItemHandler) Update(c *ginext.Context) {
 		respondError(c, http.StatusBadRequest, err.Error())
 		return
 	}
+	item.Version = version
 
 	updated, err := h.svc.Update(c.Request.Context(), item)
 	if err != nil {
@@ -142,6 +155,10 @@ func (h *ItemHandler) Update(c *ginext.Context) {
 			respondError(c, http.StatusNotFound, "item not found")
 			return
 		}
+		if errors.Is(err, domain.ErrConflict) {
+			respondError(c, http.StatusPreconditionFailed, err.Error())
+			return
+		}
 		if domain.IsValidationError(err) {
 			respondError(c, http.StatusBadRequest, err.Error())
 			return
@@ -152,9 +169,71 @@ func (h *ItemHandler) Update(c *ginext.Context) {
 		return
 	}
 
+	setItemETag(c, updated)
 	respondJSON(c, http.StatusOK, updated)
 }
 
+// Patch - PATCH /api/items/:id.
+func (h *ItemHandler) Patch(c *ginext.Context) {
+	id := c.Param("id")
+
+	version, err := parseIfMatch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	req, err := ParsePatchItemRequest(body)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	patch, err := req.ToPatch(id)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	patch.Version = version
+
+	patched, err := h.svc.Patch(c.Request.Context(), patch)
+	if err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) {
+			respondError(c, http.StatusNotFound, "item not found")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidID) {
+			respondError(c, http.StatusBadRequest, "invalid item id")
+			return
+		}
+		if errors.Is(err, domain.ErrConflict) {
+			respondError(c, http.StatusPreconditionFailed, err.Error())
+			return
+		}
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "patch item",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	setItemETag(c, patched)
+	respondJSON(c, http.StatusOK, patched)
+}
+
 // Delete - DELETE /api/items/:id.
 func (h *ItemHandler) Delete(c *ginext.Context) {
 	id := c.Param("id")
@@ -177,6 +256,213 @@ func (h *ItemHandler) Delete(c *ginext.Context) {
 	respondNoContent(c)
 }
 
+type batchRowResponse struct {
+	Index  int          `json:"index"`
+	Op     string       `json:"op"`
+	Status int          `json:"status"`
+	Item   *domain.Item `json:"item,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// Batch - POST /api/items/batch?atomic=true|false.
+func (h *ItemHandler) Batch(c *ginext.Context) {
+	atomic := true
+	if v := c.Query("atomic"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "invalid 'atomic' parameter")
+			return
+		}
+		atomic = b
+	}
+
+	var reqs []BatchOperationRequest
+	if err := c.ShouldBindJSON(&reqs); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+	if len(reqs) == 0 || len(reqs) > domain.MaxBatchSize {
+		respondError(c, http.StatusBadRequest, domain.ErrInvalidBatchSize.Error())
+		return
+	}
+
+	rows := make([]batchRowResponse, len(reqs))
+	ops := make([]domain.BatchOperation, 0, len(reqs))
+	for i, req := range reqs {
+		op, err := req.ToOperation(i)
+		if err != nil {
+			if atomic {
+				respondJSON(c, http.StatusBadRequest, map[string]interface{}{"error": err.Error(), "index": i})
+				return
+			}
+			rows[i] = batchRowResponse{Index: i, Op: req.Op, Status: http.StatusBadRequest, Error: err.Error()}
+			continue
+		}
+		ops = append(ops, op)
+	}
+
+	results, err := h.svc.Batch(c.Request.Context(), ops, atomic)
+	if err != nil {
+		var opErr *domain.BatchOpError
+		if errors.As(err, &opErr) {
+			status, msg := h.batchErrorStatus(c, opErr.Err)
+			respondJSON(c, status, map[string]interface{}{"error": msg, "index": opErr.Index})
+			return
+		}
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "apply item batch",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	for _, res := range results {
+		row := batchRowResponse{Index: res.Index, Op: res.Op, Item: res.Item}
+		switch {
+		case res.Err != nil:
+			row.Item = nil
+			row.Status, row.Error = h.batchErrorStatus(c, res.Err)
+		case res.Op == domain.BatchOpCreate:
+			row.Status = http.StatusCreated
+		case res.Op == domain.BatchOpDelete:
+			row.Status = http.StatusNoContent
+		default:
+			row.Status = http.StatusOK
+		}
+		rows[res.Index] = row
+	}
+
+	respondJSON(c, http.StatusOK, map[string]interface{}{"results": rows})
+}
+
+// batchErrorStatus переводит ошибку строки пакета в HTTP-статус и текст для клиента.
+func (h *ItemHandler) batchErrorStatus(c *ginext.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrItemNotFound):
+		return http.StatusNotFound, "item not found"
+	case errors.Is(err, domain.ErrInvalidID):
+		return http.StatusBadRequest, "invalid item id"
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusPreconditionFailed, err.Error()
+	case domain.IsValidationError(err):
+		return http.StatusBadRequest, err.Error()
+	default:
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "item batch row",
+			logger.String("error", err.Error()))
+		return http.StatusInternalServerError, "internal server error"
+	}
+}
+
+// Restore - POST /api/items/:id/restore.
+func (h *ItemHandler) Restore(c *ginext.Context) {
+	id := c.Param("id")
+
+	restored, err := h.svc.Restore(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) {
+			respondError(c, http.StatusNotFound, "item not found in trash")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidID) {
+			respondError(c, http.StatusBadRequest, "invalid item id")
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "restore item",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	setItemETag(c, restored)
+	respondJSON(c, http.StatusOK, restored)
+}
+
+// Trash - GET /api/trash.
+func (h *ItemHandler) Trash(c *ginext.Context) {
+	filter, err := parseItemFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.Deleted = true
+
+	page, err := h.svc.List(c.Request.Context(), filter)
+	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "list trash",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, pageResponse(page))
+}
+
+// defaultPurgeAgeDays — срок хранения в корзине, если older_than_days не передан.
+const defaultPurgeAgeDays = 30
+
+// Purge - DELETE /api/trash?older_than_days=N.
+func (h *ItemHandler) Purge(c *ginext.Context) {
+	days := defaultPurgeAgeDays
+	if v := c.Query("older_than_days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, domain.ErrInvalidPurgeAge.Error())
+			return
+		}
+		days = n
+	}
+
+	purged, err := h.svc.Purge(c.Request.Context(), days)
+	if errors.Is(err, domain.ErrAttachmentCleanup) {
+		// записи удалены, в хранилище остались только файлы вложений
+		h.log.LogAttrs(c.Request.Context(), logger.WarnLevel, "purge attachment files",
+			logger.String("error", err.Error()))
+		err = nil
+	}
+	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "purge trash",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, map[string]interface{}{"purged": purged})
+}
+
+// History - GET /api/items/:id/history.
+func (h *ItemHandler) History(c *ginext.Context) {
+	id := c.Param("id")
+
+	entries, err := h.svc.History(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) {
+			respondError(c, http.StatusNotFound, "item not found")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidID) {
+			respondError(c, http.StatusBadRequest, "invalid item id")
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "get item history",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, map[string]interface{}{"history": entries})
+}
+
 func parseItemFilter(c *ginext.Context) (domain.ItemFilter, error) {
 	var filter domain.ItemFilter
 
@@ -194,11 +480,20 @@ func parseItemFilter(c *ginext.Context) (domain.ItemFilter, error) {
 		}
 		filter.To = &t
 	}
-	filter.Category = c.Query("category")
 	filter.Type = c.Query("type")
+	filter.Tags = parseTagsQuery(c)
+	filter.TagMatch = c.Query("tag_match")
+	filter.Query = strings.TrimSpace(c.Query("q"))
+	filter.AccountID = c.Query("account_id")
 	filter.SortBy = c.Query("sort_by")
 	filter.Order = c.Query("order")
 
+	fields, err := parseFieldFilter(c)
+	if err != nil {
+		return filter, err
+	}
+	filter.FieldFilter = fields
+
 	if v := c.Query("limit"); v != "" {
 		n, err := strconv.Atoi(v)
 		if err != nil || n < 0 {
@@ -213,6 +508,90 @@ func parseItemFilter(c *ginext.Context) (domain.ItemFilter, error) {
 		}
 		filter.Offset = n
 	}
+	if v := c.Query("cursor"); v != "" {
+		cursor, err := domain.DecodeItemCursor(v)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = &cursor
+	}
+	if v := c.Query("with_total"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.New("invalid 'with_total' parameter")
+		}
+		filter.SkipTotal = !b
+	}
 
 	return filter, nil
 }
+
+// pageResponse — тело ответа со страницей записей; total_count отсутствует при with_total=false,
+// next_cursor равен null на последней странице.
+func pageResponse(page domain.ItemPage) map[string]interface{} {
+	items := page.Items
+	if items == nil {
+		items = []domain.Item{}
+	}
+	response := map[string]interface{}{
+		"items":       items,
+		"next_cursor": nil,
+	}
+	if page.TotalCount != nil {
+		response["total_count"] = *page.TotalCount
+	}
+	if page.NextCursor != "" {
+		response["next_cursor"] = page.NextCursor
+	}
+	return response
+}
+
+// parseFieldFilter читает min_amount, max_amount, category=a,b, exclude_category=a,b и description_prefix.
+func parseFieldFilter(c *ginext.Context) (domain.FieldFilter, error) {
+	var filter domain.FieldFilter
+
+	if v := c.Query("min_amount"); v != "" {
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return filter, errors.New("invalid 'min_amount' parameter")
+		}
+		filter.MinAmount = &d
+	}
+	if v := c.Query("max_amount"); v != "" {
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return filter, errors.New("invalid 'max_amount' parameter")
+		}
+		filter.MaxAmount = &d
+	}
+
+	filter.Categories = parseListQuery(c, "category")
+	filter.ExcludeCategories = parseListQuery(c, "exclude_category")
+	filter.DescriptionPrefix = c.Query("description_prefix")
+
+	return filter, nil
+}
+
+// parseListQuery читает список через запятую; пустые элементы отбрасываются.
+func parseListQuery(c *ginext.Context, name string) []string {
+	var list []string
+	for _, v := range strings.Split(c.Query(name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// parseTagsQuery читает tags=a,b; пустой параметр означает «без фильтра по тегам».
+func parseTagsQuery(c *ginext.Context) []string {
+	v := c.Query("tags")
+	if v == "" {
+		return nil
+	}
+	tags := domain.NormalizeTags(strings.Split(v, ","))
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
@@ -23,9 +23,15 @@ func setupItemRouter(h *ItemHandler) *gin.Engine {
 	r := gin.New()
 	r.POST("/api/items", gin.HandlerFunc(h.Create))
 	r.GET("/api/items", gin.HandlerFunc(h.List))
+	r.POST("/api/items/batch", gin.HandlerFunc(h.Batch))
 	r.GET("/api/items/:id", gin.HandlerFunc(h.GetByID))
 	r.PUT("/api/items/:id", gin.HandlerFunc(h.Update))
+	r.PATCH("/api/items/:id", gin.HandlerFunc(h.Patch))
 	r.DELETE("/api/items/:id", gin.HandlerFunc(h.Delete))
+	r.GET("/api/items/:id/history", gin.HandlerFunc(h.History))
+	r.POST("/api/items/:id/restore", gin.HandlerFunc(h.Restore))
+	r.GET("/api/trash", gin.HandlerFunc(h.Trash))
+	r.DELETE("/api/trash", gin.HandlerFunc(h.Purge))
 	return r
 }
 
@@ -50,9 +56,14 @@ func testItem() domain.Item {
 		Date:        time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
 		CreatedAt:   time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC),
 		UpdatedAt:   time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC),
+		Version:     1,
 	}
 }
 
+func testPage(items []domain.Item, total int64) domain.ItemPage {
+	return domain.ItemPage{Items: items, TotalCount: &total}
+}
+
 func TestItemHandler_Create_Success(t *testing.T) {
 	svc := newMockitemService(t)
 	h := NewItemHandler(svc, newTestLogger(t))
@@ -105,6 +116,25 @@ func TestItemHandler_Create_ValidationError(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestItemHandler_Create_UnknownCategory(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.Anything).
+		Return(domain.Item{}, fmt.Errorf("%w: %q", domain.ErrUnknownCategory, "misc"))
+
+	body := `{"type":"income","amount":100,"category":"misc","date":"2024-06-15"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/items", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "category does not exist")
+}
+
 func TestItemHandler_Create_ServiceError(t *testing.T) {
 	svc := newMockitemService(t)
 	h := NewItemHandler(svc, newTestLogger(t))
@@ -128,7 +158,7 @@ func TestItemHandler_List_Success(t *testing.T) {
 	router := setupItemRouter(h)
 
 	items := []domain.Item{testItem()}
-	svc.EXPECT().List(mock.Anything, mock.Anything).Return(items, int64(1), nil)
+	svc.EXPECT().List(mock.Anything, mock.Anything).Return(testPage(items, 1), nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/items", nil)
 	w := httptest.NewRecorder()
@@ -149,7 +179,7 @@ func TestItemHandler_List_EmptyResult(t *testing.T) {
 	h := NewItemHandler(svc, newTestLogger(t))
 	router := setupItemRouter(h)
 
-	svc.EXPECT().List(mock.Anything, mock.Anything).Return(nil, int64(0), nil)
+	svc.EXPECT().List(mock.Anything, mock.Anything).Return(testPage(nil, 0), nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/items", nil)
 	w := httptest.NewRecorder()
@@ -162,6 +192,7 @@ func TestItemHandler_List_EmptyResult(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
 	assert.Equal(t, float64(0), resp["total_count"])
+	assert.Nil(t, resp["next_cursor"])
 	items := resp["items"].([]interface{})
 	assert.Empty(t, items)
 }
@@ -179,13 +210,131 @@ func TestItemHandler_List_InvalidFilter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestItemHandler_List_TagsFilter(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.ItemFilter) bool {
+		return assert.ObjectsAreEqual([]string{"reimbursable", "vacation-2026"}, f.Tags) &&
+			f.TagMatch == domain.TagMatchAll
+	})).Return(testPage(nil, 0), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items?tags=Vacation-2026,,reimbursable&tag_match=all", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestItemHandler_List_Search(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.ItemFilter) bool {
+		return f.Query == "pharmacy -vitamins" && f.SortBy == domain.SortByRelevance && f.Type == domain.TypeExpense
+	})).Return(testPage(nil, 0), nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/items?q=+pharmacy+-vitamins+&sort_by=relevance&type=expense", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestItemHandler_List_FieldFilters(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.ItemFilter) bool {
+		return f.MinAmount.String() == "10.5" && f.MaxAmount.String() == "200" &&
+			assert.ObjectsAreEqual([]string{"Food", "Cafe"}, f.Categories) &&
+			assert.ObjectsAreEqual([]string{"Taxi"}, f.ExcludeCategories) &&
+			f.DescriptionPrefix == "Lunch"
+	})).Return(testPage(nil, 0), nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/items?min_amount=10.5&max_amount=200&category=Food,+Cafe,&exclude_category=Taxi&description_prefix=Lunch", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestItemHandler_List_InvalidAmount(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items?max_amount=abc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestItemHandler_List_Cursor(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	cursor := domain.ItemCursor{
+		SortBy: domain.SortByAmount, Order: domain.OrderAsc, Key: "150.00", ID: testItemID(),
+	}
+	next := domain.ItemCursor{
+		SortBy: domain.SortByAmount, Order: domain.OrderAsc, Key: "200.00", ID: testItemID(),
+	}.Encode()
+
+	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.ItemFilter) bool {
+		return f.Cursor != nil && *f.Cursor == cursor && f.SkipTotal && f.Limit == 1
+	})).Return(domain.ItemPage{Items: []domain.Item{testItem()}, NextCursor: next}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/items?sort_by=amount&order=asc&limit=1&with_total=false&cursor="+cursor.Encode(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, next, resp["next_cursor"])
+	assert.NotContains(t, resp, "total_count")
+}
+
+func TestItemHandler_List_InvalidCursor(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	for _, cursor := range []string{
+		"not-base64!",
+		domain.ItemCursor{SortBy: domain.SortByDate, Order: domain.OrderDesc, Key: "yesterday", ID: testItemID()}.Encode(),
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/items?cursor="+cursor, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
 func TestItemHandler_List_ValidationError(t *testing.T) {
 	svc := newMockitemService(t)
 	h := NewItemHandler(svc, newTestLogger(t))
 	router := setupItemRouter(h)
 
 	valErr := fmt.Errorf("validate filter: %w", domain.ErrInvalidType)
-	svc.EXPECT().List(mock.Anything, mock.Anything).Return(nil, int64(0), valErr)
+	svc.EXPECT().List(mock.Anything, mock.Anything).Return(domain.ItemPage{}, valErr)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/items?type=bad", nil)
 	w := httptest.NewRecorder()
@@ -265,6 +414,26 @@ func TestItemHandler_Update_Success(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestItemHandler_Update_KeepsCurrency(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	// без currency валюта записи не сбрасывается к RUB
+	svc.EXPECT().Update(mock.Anything, mock.MatchedBy(func(item domain.Item) bool {
+		return item.Currency == ""
+	})).Return(testItem(), nil)
+
+	body := `{"type":"income","amount":200,"category":"salary","date":"2024-06-15"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/items/"+testItemID(), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
 func TestItemHandler_Update_NotFound(t *testing.T) {
 	svc := newMockitemService(t)
 	h := NewItemHandler(svc, newTestLogger(t))
@@ -296,6 +465,133 @@ func TestItemHandler_Update_InvalidJSON(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestItemHandler_GetByID_SetsETag(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	item := testItem()
+	item.Version = 7
+	svc.EXPECT().GetByID(mock.Anything, testItemID()).Return(item, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"7"`, w.Header().Get("ETag"))
+}
+
+func TestItemHandler_Update_IfMatch(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	updated := testItem()
+	updated.Version = 4
+	svc.EXPECT().Update(mock.Anything, mock.MatchedBy(func(item domain.Item) bool {
+		return item.Version == 3
+	})).Return(updated, nil)
+
+	body := `{"type":"income","amount":200,"category":"salary","date":"2024-06-15"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/items/"+testItemID(), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"3"`)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+func TestItemHandler_Update_Conflict(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Update(mock.Anything, mock.Anything).Return(domain.Item{}, domain.ErrConflict)
+
+	body := `{"type":"income","amount":200,"category":"salary","date":"2024-06-15"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/items/"+testItemID(), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `W/"2"`)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
+func TestItemHandler_Update_InvalidIfMatch(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	body := `{"type":"income","amount":200,"category":"salary","date":"2024-06-15"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/items/"+testItemID(), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", "2")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestItemHandler_Patch_Success(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	patched := testItem()
+	patched.Category = "bonus"
+	svc.EXPECT().Patch(mock.Anything, mock.MatchedBy(func(p domain.ItemPatch) bool {
+		return p.ID == testItemID() && p.Version == 1 &&
+			p.Category != nil && *p.Category == "bonus" && p.Type == nil && p.Amount == nil
+	})).Return(patched, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/items/"+testItemID(), bytes.NewBufferString(`{"category":"bonus"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `"1"`)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEmpty(t, w.Header().Get("ETag"))
+}
+
+func TestItemHandler_Patch_ValidationError(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/items/"+testItemID(), bytes.NewBufferString(`{"amount":-5}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestItemHandler_Patch_NotFound(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Patch(mock.Anything, mock.Anything).Return(domain.Item{}, domain.ErrItemNotFound)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/items/"+testItemID(), bytes.NewBufferString(`{"description":"x"}`))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 func TestItemHandler_Delete_Success(t *testing.T) {
 	svc := newMockitemService(t)
 	h := NewItemHandler(svc, newTestLogger(t))
@@ -340,3 +636,245 @@ func TestItemHandler_Delete_InvalidID(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func TestItemHandler_Batch_Atomic(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	created := testItem()
+	svc.EXPECT().Batch(mock.Anything, mock.MatchedBy(func(ops []domain.BatchOperation) bool {
+		return len(ops) == 2 &&
+			ops[0].Op == domain.BatchOpCreate && ops[0].Item.Category == "salary" &&
+			ops[1].Op == domain.BatchOpDelete && ops[1].Item.ID == testItemID() && ops[1].Index == 1
+	}), true).Return([]domain.BatchResult{
+		{Index: 0, Op: domain.BatchOpCreate, Item: &created},
+		{Index: 1, Op: domain.BatchOpDelete},
+	}, nil)
+
+	body := `[
+		{"op":"create","item":{"type":"income","amount":100,"category":"salary","date":"2024-06-15"}},
+		{"op":"delete","id":"` + testItemID() + `"}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/api/items/batch", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string][]batchRowResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp["results"], 2)
+	assert.Equal(t, http.StatusCreated, resp["results"][0].Status)
+	assert.Equal(t, http.StatusNoContent, resp["results"][1].Status)
+}
+
+func TestItemHandler_Batch_AtomicRowInvalid(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	body := `[
+		{"op":"create","item":{"type":"income","amount":100,"category":"salary","date":"2024-06-15"}},
+		{"op":"update","id":"` + testItemID() + `","item":{"type":"income","amount":0,"category":"salary","date":"2024-06-15"}}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/api/items/batch", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"index":1`)
+}
+
+func TestItemHandler_Batch_AtomicRolledBack(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Batch(mock.Anything, mock.Anything, true).
+		Return(nil, &domain.BatchOpError{Index: 0, Err: domain.ErrItemNotFound})
+
+	body := `[{"op":"delete","id":"` + testItemID() + `"}]`
+	req := httptest.NewRequest(http.MethodPost, "/api/items/batch", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"error":"item not found","index":0}`, w.Body.String())
+}
+
+func TestItemHandler_Batch_NonAtomic(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	created := testItem()
+	svc.EXPECT().Batch(mock.Anything, mock.MatchedBy(func(ops []domain.BatchOperation) bool {
+		return len(ops) == 2 && ops[0].Index == 0 && ops[1].Index == 2
+	}), false).Return([]domain.BatchResult{
+		{Index: 0, Op: domain.BatchOpCreate, Item: &created},
+		{Index: 2, Op: domain.BatchOpDelete, Err: domain.ErrItemNotFound},
+	}, nil)
+
+	body := `[
+		{"op":"create","item":{"type":"income","amount":100,"category":"salary","date":"2024-06-15"}},
+		{"op":"create","item":{"type":"gift","amount":100,"category":"salary","date":"2024-06-15"}},
+		{"op":"delete","id":"` + testItemID() + `"}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/api/items/batch?atomic=false", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string][]batchRowResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp["results"], 3)
+	assert.Equal(t, http.StatusCreated, resp["results"][0].Status)
+	assert.Equal(t, http.StatusBadRequest, resp["results"][1].Status)
+	assert.NotEmpty(t, resp["results"][1].Error)
+	assert.Equal(t, http.StatusNotFound, resp["results"][2].Status)
+	assert.Nil(t, resp["results"][2].Item)
+}
+
+func TestItemHandler_Batch_Empty(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/items/batch", bytes.NewBufferString(`[]`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestItemHandler_Restore_Success(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Restore(mock.Anything, testItemID()).Return(testItem(), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/items/"+testItemID()+"/restore", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestItemHandler_Restore_NotInTrash(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Restore(mock.Anything, testItemID()).Return(domain.Item{}, domain.ErrItemNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/items/"+testItemID()+"/restore", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestItemHandler_Trash_OnlyDeleted(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	deletedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	item := testItem()
+	item.DeletedAt = &deletedAt
+	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.ItemFilter) bool {
+		return f.Deleted && assert.ObjectsAreEqual([]string{"food"}, f.Categories)
+	})).Return(testPage([]domain.Item{item}, 1), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/trash?category=food", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"deleted_at":"2024-02-01T00:00:00Z"`)
+}
+
+func TestItemHandler_Purge_DefaultAge(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().Purge(mock.Anything, 30).Return(int64(2), nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/trash", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"purged":2}`, w.Body.String())
+}
+
+func TestItemHandler_Purge_InvalidAge(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/trash?older_than_days=abc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestItemHandler_History_Success(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	item := testItem()
+	entries := []domain.ItemHistoryEntry{
+		{ID: 1, ItemID: testItemID(), Action: domain.HistoryActionCreate, NewValue: &item, RequestID: "req-1"},
+	}
+	svc.EXPECT().History(mock.Anything, testItemID()).Return(entries, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items/"+testItemID()+"/history", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string][]domain.ItemHistoryEntry
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp["history"], 1)
+	assert.Equal(t, "req-1", resp["history"][0].RequestID)
+	assert.Nil(t, resp["history"][0].OldValue)
+}
+
+func TestItemHandler_History_NotFound(t *testing.T) {
+	svc := newMockitemService(t)
+	h := NewItemHandler(svc, newTestLogger(t))
+	router := setupItemRouter(h)
+
+	svc.EXPECT().History(mock.Anything, testItemID()).Return(nil, domain.ErrItemNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items/"+testItemID()+"/history", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -6,18 +6,20 @@ package handler
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// newMockanalyticsService creates a new instance of mockanalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// newMockaccountService creates a new instance of mockaccountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func newMockanalyticsService(t interface {
+func newMockaccountService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *mockanalyticsService {
-	mock := &mockanalyticsService{}
+}) *mockaccountService {
+	mock := &mockaccountService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,167 +27,138 @@ func newMockanalyticsService(t interface {
 	return mock
 }
 
-// mockanalyticsService is an autogenerated mock type for the analyticsService type
-type mockanalyticsService struct {
+// mockaccountService is an autogenerated mock type for the accountService type
+type mockaccountService struct {
 	mock.Mock
 }
 
-type mockanalyticsService_Expecter struct {
+type mockaccountService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *mockanalyticsService) EXPECT() *mockanalyticsService_Expecter {
-	return &mockanalyticsService_Expecter{mock: &_m.Mock}
+func (_m *mockaccountService) EXPECT() *mockaccountService_Expecter {
+	return &mockaccountService_Expecter{mock: &_m.Mock}
 }
 
-// GetAnalytics provides a mock function for the type mockanalyticsService
-func (_mock *mockanalyticsService) GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsResult, error) {
-	ret := _mock.Called(ctx, filter)
+// Balance provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) Balance(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error) {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAnalytics")
+		panic("no return value specified for Balance")
 	}
 
-	var r0 domain.AnalyticsResult
+	var r0 domain.AccountBalance
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) (domain.AnalyticsResult, error)); ok {
-		return returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (domain.AccountBalance, error)); ok {
+		return returnFunc(ctx, id, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) domain.AnalyticsResult); ok {
-		r0 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) domain.AccountBalance); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
-		r0 = ret.Get(0).(domain.AnalyticsResult)
+		r0 = ret.Get(0).(domain.AccountBalance)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
-		r1 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockanalyticsService_GetAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalytics'
-type mockanalyticsService_GetAnalytics_Call struct {
+// mockaccountService_Balance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Balance'
+type mockaccountService_Balance_Call struct {
 	*mock.Call
 }
 
-// GetAnalytics is a helper method to define mock.On call
+// Balance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter domain.AnalyticsFilter
-func (_e *mockanalyticsService_Expecter) GetAnalytics(ctx interface{}, filter interface{}) *mockanalyticsService_GetAnalytics_Call {
-	return &mockanalyticsService_GetAnalytics_Call{Call: _e.mock.On("GetAnalytics", ctx, filter)}
+//   - id string
+//   - at time.Time
+func (_e *mockaccountService_Expecter) Balance(ctx interface{}, id interface{}, at interface{}) *mockaccountService_Balance_Call {
+	return &mockaccountService_Balance_Call{Call: _e.mock.On("Balance", ctx, id, at)}
 }
 
-func (_c *mockanalyticsService_GetAnalytics_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsService_GetAnalytics_Call {
+func (_c *mockaccountService_Balance_Call) Run(run func(ctx context.Context, id string, at time.Time)) *mockaccountService_Balance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.AnalyticsFilter
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(domain.AnalyticsFilter)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *mockanalyticsService_GetAnalytics_Call) Return(analyticsResult domain.AnalyticsResult, err error) *mockanalyticsService_GetAnalytics_Call {
-	_c.Call.Return(analyticsResult, err)
+func (_c *mockaccountService_Balance_Call) Return(accountBalance domain.AccountBalance, err error) *mockaccountService_Balance_Call {
+	_c.Call.Return(accountBalance, err)
 	return _c
 }
 
-func (_c *mockanalyticsService_GetAnalytics_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsResult, error)) *mockanalyticsService_GetAnalytics_Call {
+func (_c *mockaccountService_Balance_Call) RunAndReturn(run func(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error)) *mockaccountService_Balance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// newMockexportItemService creates a new instance of mockexportItemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func newMockexportItemService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *mockexportItemService {
-	mock := &mockexportItemService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// mockexportItemService is an autogenerated mock type for the exportItemService type
-type mockexportItemService struct {
-	mock.Mock
-}
-
-type mockexportItemService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *mockexportItemService) EXPECT() *mockexportItemService_Expecter {
-	return &mockexportItemService_Expecter{mock: &_m.Mock}
-}
-
-// List provides a mock function for the type mockexportItemService
-func (_mock *mockexportItemService) List(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error) {
-	ret := _mock.Called(ctx, filter)
+// Create provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) Create(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	ret := _mock.Called(ctx, acc)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for Create")
 	}
 
-	var r0 []domain.Item
-	var r1 int64
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) ([]domain.Item, int64, error)); ok {
-		return returnFunc(ctx, filter)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) []domain.Item); ok {
-		r0 = returnFunc(ctx, filter)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Item)
-		}
+	var r0 domain.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) (domain.Account, error)); ok {
+		return returnFunc(ctx, acc)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemFilter) int64); ok {
-		r1 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) domain.Account); ok {
+		r0 = returnFunc(ctx, acc)
 	} else {
-		r1 = ret.Get(1).(int64)
+		r0 = ret.Get(0).(domain.Account)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.ItemFilter) error); ok {
-		r2 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Account) error); ok {
+		r1 = returnFunc(ctx, acc)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// mockexportItemService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type mockexportItemService_List_Call struct {
+// mockaccountService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockaccountService_Create_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter domain.ItemFilter
-func (_e *mockexportItemService_Expecter) List(ctx interface{}, filter interface{}) *mockexportItemService_List_Call {
-	return &mockexportItemService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+//   - acc domain.Account
+func (_e *mockaccountService_Expecter) Create(ctx interface{}, acc interface{}) *mockaccountService_Create_Call {
+	return &mockaccountService_Create_Call{Call: _e.mock.On("Create", ctx, acc)}
 }
 
-func (_c *mockexportItemService_List_Call) Run(run func(ctx context.Context, filter domain.ItemFilter)) *mockexportItemService_List_Call {
+func (_c *mockaccountService_Create_Call) Run(run func(ctx context.Context, acc domain.Account)) *mockaccountService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.ItemFilter
+		var arg1 domain.Account
 		if args[1] != nil {
-			arg1 = args[1].(domain.ItemFilter)
+			arg1 = args[1].(domain.Account)
 		}
 		run(
 			arg0,
@@ -195,90 +168,54 @@ func (_c *mockexportItemService_List_Call) Run(run func(ctx context.Context, fil
 	return _c
 }
 
-func (_c *mockexportItemService_List_Call) Return(items []domain.Item, n int64, err error) *mockexportItemService_List_Call {
-	_c.Call.Return(items, n, err)
+func (_c *mockaccountService_Create_Call) Return(account domain.Account, err error) *mockaccountService_Create_Call {
+	_c.Call.Return(account, err)
 	return _c
 }
 
-func (_c *mockexportItemService_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error)) *mockexportItemService_List_Call {
+func (_c *mockaccountService_Create_Call) RunAndReturn(run func(ctx context.Context, acc domain.Account) (domain.Account, error)) *mockaccountService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// newMockitemService creates a new instance of mockitemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func newMockitemService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *mockitemService {
-	mock := &mockitemService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// mockitemService is an autogenerated mock type for the itemService type
-type mockitemService struct {
-	mock.Mock
-}
-
-type mockitemService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *mockitemService) EXPECT() *mockitemService_Expecter {
-	return &mockitemService_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type mockitemService
-func (_mock *mockitemService) Create(ctx context.Context, item domain.Item) (domain.Item, error) {
-	ret := _mock.Called(ctx, item)
+// Delete provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Delete")
 	}
 
-	var r0 domain.Item
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
-		return returnFunc(ctx, item)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
-		r0 = returnFunc(ctx, item)
-	} else {
-		r0 = ret.Get(0).(domain.Item)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
-		r1 = returnFunc(ctx, item)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// mockitemService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type mockitemService_Create_Call struct {
+// mockaccountService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockaccountService_Delete_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - item domain.Item
-func (_e *mockitemService_Expecter) Create(ctx interface{}, item interface{}) *mockitemService_Create_Call {
-	return &mockitemService_Create_Call{Call: _e.mock.On("Create", ctx, item)}
+//   - id string
+func (_e *mockaccountService_Expecter) Delete(ctx interface{}, id interface{}) *mockaccountService_Delete_Call {
+	return &mockaccountService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *mockitemService_Create_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemService_Create_Call {
+func (_c *mockaccountService_Delete_Call) Run(run func(ctx context.Context, id string)) *mockaccountService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.Item
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(domain.Item)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -288,46 +225,55 @@ func (_c *mockitemService_Create_Call) Run(run func(ctx context.Context, item do
 	return _c
 }
 
-func (_c *mockitemService_Create_Call) Return(item1 domain.Item, err error) *mockitemService_Create_Call {
-	_c.Call.Return(item1, err)
+func (_c *mockaccountService_Delete_Call) Return(err error) *mockaccountService_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *mockitemService_Create_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemService_Create_Call {
+func (_c *mockaccountService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockaccountService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type mockitemService
-func (_mock *mockitemService) Delete(ctx context.Context, id string) error {
+// GetByID provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) GetByID(ctx context.Context, id string) (domain.Account, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+	var r0 domain.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Account, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Account); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(domain.Account)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// mockitemService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type mockitemService_Delete_Call struct {
+// mockaccountService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockaccountService_GetByID_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *mockitemService_Expecter) Delete(ctx interface{}, id interface{}) *mockitemService_Delete_Call {
-	return &mockitemService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *mockaccountService_Expecter) GetByID(ctx interface{}, id interface{}) *mockaccountService_GetByID_Call {
+	return &mockaccountService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *mockitemService_Delete_Call) Run(run func(ctx context.Context, id string)) *mockitemService_Delete_Call {
+func (_c *mockaccountService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockaccountService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -345,63 +291,65 @@ func (_c *mockitemService_Delete_Call) Run(run func(ctx context.Context, id stri
 	return _c
 }
 
-func (_c *mockitemService_Delete_Call) Return(err error) *mockitemService_Delete_Call {
-	_c.Call.Return(err)
+func (_c *mockaccountService_GetByID_Call) Return(account domain.Account, err error) *mockaccountService_GetByID_Call {
+	_c.Call.Return(account, err)
 	return _c
 }
 
-func (_c *mockitemService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockitemService_Delete_Call {
+func (_c *mockaccountService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Account, error)) *mockaccountService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type mockitemService
-func (_mock *mockitemService) GetByID(ctx context.Context, id string) (domain.Item, error) {
-	ret := _mock.Called(ctx, id)
+// List provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) List(ctx context.Context, includeClosed bool) ([]domain.Account, error) {
+	ret := _mock.Called(ctx, includeClosed)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for List")
 	}
 
-	var r0 domain.Item
+	var r0 []domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Item, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]domain.Account, error)); ok {
+		return returnFunc(ctx, includeClosed)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Item); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []domain.Account); ok {
+		r0 = returnFunc(ctx, includeClosed)
 	} else {
-		r0 = ret.Get(0).(domain.Item)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Account)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeClosed)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockitemService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type mockitemService_GetByID_Call struct {
+// mockaccountService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockaccountService_List_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *mockitemService_Expecter) GetByID(ctx interface{}, id interface{}) *mockitemService_GetByID_Call {
-	return &mockitemService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - includeClosed bool
+func (_e *mockaccountService_Expecter) List(ctx interface{}, includeClosed interface{}) *mockaccountService_List_Call {
+	return &mockaccountService_List_Call{Call: _e.mock.On("List", ctx, includeClosed)}
 }
 
-func (_c *mockitemService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockitemService_GetByID_Call {
+func (_c *mockaccountService_List_Call) Run(run func(ctx context.Context, includeClosed bool)) *mockaccountService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 bool
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(bool)
 		}
 		run(
 			arg0,
@@ -411,71 +359,77 @@ func (_c *mockitemService_GetByID_Call) Run(run func(ctx context.Context, id str
 	return _c
 }
 
-func (_c *mockitemService_GetByID_Call) Return(item domain.Item, err error) *mockitemService_GetByID_Call {
-	_c.Call.Return(item, err)
+func (_c *mockaccountService_List_Call) Return(accounts []domain.Account, err error) *mockaccountService_List_Call {
+	_c.Call.Return(accounts, err)
 	return _c
 }
 
-func (_c *mockitemService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Item, error)) *mockitemService_GetByID_Call {
+func (_c *mockaccountService_List_Call) RunAndReturn(run func(ctx context.Context, includeClosed bool) ([]domain.Account, error)) *mockaccountService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type mockitemService
-func (_mock *mockitemService) List(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error) {
+// Statement provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) Statement(ctx context.Context, filter domain.StatementFilter) (domain.Account, []domain.StatementEntry, int64, error) {
 	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for Statement")
 	}
 
-	var r0 []domain.Item
-	var r1 int64
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) ([]domain.Item, int64, error)); ok {
+	var r0 domain.Account
+	var r1 []domain.StatementEntry
+	var r2 int64
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatementFilter) (domain.Account, []domain.StatementEntry, int64, error)); ok {
 		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) []domain.Item); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatementFilter) domain.Account); ok {
 		r0 = returnFunc(ctx, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Item)
-		}
+		r0 = ret.Get(0).(domain.Account)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemFilter) int64); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatementFilter) []domain.StatementEntry); ok {
 		r1 = returnFunc(ctx, filter)
 	} else {
-		r1 = ret.Get(1).(int64)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.StatementEntry)
+		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.ItemFilter) error); ok {
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.StatementFilter) int64); ok {
 		r2 = returnFunc(ctx, filter)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(int64)
 	}
-	return r0, r1, r2
+	if returnFunc, ok := ret.Get(3).(func(context.Context, domain.StatementFilter) error); ok {
+		r3 = returnFunc(ctx, filter)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
 }
 
-// mockitemService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type mockitemService_List_Call struct {
+// mockaccountService_Statement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statement'
+type mockaccountService_Statement_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Statement is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter domain.ItemFilter
-func (_e *mockitemService_Expecter) List(ctx interface{}, filter interface{}) *mockitemService_List_Call {
-	return &mockitemService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+//   - filter domain.StatementFilter
+func (_e *mockaccountService_Expecter) Statement(ctx interface{}, filter interface{}) *mockaccountService_Statement_Call {
+	return &mockaccountService_Statement_Call{Call: _e.mock.On("Statement", ctx, filter)}
 }
 
-func (_c *mockitemService_List_Call) Run(run func(ctx context.Context, filter domain.ItemFilter)) *mockitemService_List_Call {
+func (_c *mockaccountService_Statement_Call) Run(run func(ctx context.Context, filter domain.StatementFilter)) *mockaccountService_Statement_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.ItemFilter
+		var arg1 domain.StatementFilter
 		if args[1] != nil {
-			arg1 = args[1].(domain.ItemFilter)
+			arg1 = args[1].(domain.StatementFilter)
 		}
 		run(
 			arg0,
@@ -485,63 +439,63 @@ func (_c *mockitemService_List_Call) Run(run func(ctx context.Context, filter do
 	return _c
 }
 
-func (_c *mockitemService_List_Call) Return(items []domain.Item, n int64, err error) *mockitemService_List_Call {
-	_c.Call.Return(items, n, err)
+func (_c *mockaccountService_Statement_Call) Return(account domain.Account, statementEntrys []domain.StatementEntry, n int64, err error) *mockaccountService_Statement_Call {
+	_c.Call.Return(account, statementEntrys, n, err)
 	return _c
 }
 
-func (_c *mockitemService_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error)) *mockitemService_List_Call {
+func (_c *mockaccountService_Statement_Call) RunAndReturn(run func(ctx context.Context, filter domain.StatementFilter) (domain.Account, []domain.StatementEntry, int64, error)) *mockaccountService_Statement_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type mockitemService
-func (_mock *mockitemService) Update(ctx context.Context, item domain.Item) (domain.Item, error) {
-	ret := _mock.Called(ctx, item)
+// Update provides a mock function for the type mockaccountService
+func (_mock *mockaccountService) Update(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	ret := _mock.Called(ctx, acc)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
-	var r0 domain.Item
+	var r0 domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
-		return returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) (domain.Account, error)); ok {
+		return returnFunc(ctx, acc)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
-		r0 = returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) domain.Account); ok {
+		r0 = returnFunc(ctx, acc)
 	} else {
-		r0 = ret.Get(0).(domain.Item)
+		r0 = ret.Get(0).(domain.Account)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
-		r1 = returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Account) error); ok {
+		r1 = returnFunc(ctx, acc)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockitemService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type mockitemService_Update_Call struct {
+// mockaccountService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockaccountService_Update_Call struct {
 	*mock.Call
 }
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
-//   - item domain.Item
-func (_e *mockitemService_Expecter) Update(ctx interface{}, item interface{}) *mockitemService_Update_Call {
-	return &mockitemService_Update_Call{Call: _e.mock.On("Update", ctx, item)}
+//   - acc domain.Account
+func (_e *mockaccountService_Expecter) Update(ctx interface{}, acc interface{}) *mockaccountService_Update_Call {
+	return &mockaccountService_Update_Call{Call: _e.mock.On("Update", ctx, acc)}
 }
 
-func (_c *mockitemService_Update_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemService_Update_Call {
+func (_c *mockaccountService_Update_Call) Run(run func(ctx context.Context, acc domain.Account)) *mockaccountService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.Item
+		var arg1 domain.Account
 		if args[1] != nil {
-			arg1 = args[1].(domain.Item)
+			arg1 = args[1].(domain.Account)
 		}
 		run(
 			arg0,
@@ -551,12 +505,2909 @@ func (_c *mockitemService_Update_Call) Run(run func(ctx context.Context, item do
 	return _c
 }
 
-func (_c *mockitemService_Update_Call) Return(item1 domain.Item, err error) *mockitemService_Update_Call {
-	_c.Call.Return(item1, err)
+func (_c *mockaccountService_Update_Call) Return(account domain.Account, err error) *mockaccountService_Update_Call {
+	_c.Call.Return(account, err)
 	return _c
 }
 
-func (_c *mockitemService_Update_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemService_Update_Call {
+func (_c *mockaccountService_Update_Call) RunAndReturn(run func(ctx context.Context, acc domain.Account) (domain.Account, error)) *mockaccountService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockanalyticsService creates a new instance of mockanalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockanalyticsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockanalyticsService {
+	mock := &mockanalyticsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockanalyticsService is an autogenerated mock type for the analyticsService type
+type mockanalyticsService struct {
+	mock.Mock
+}
+
+type mockanalyticsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockanalyticsService) EXPECT() *mockanalyticsService_Expecter {
+	return &mockanalyticsService_Expecter{mock: &_m.Mock}
+}
+
+// GetAnalytics provides a mock function for the type mockanalyticsService
+func (_mock *mockanalyticsService) GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsReport, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalytics")
+	}
+
+	var r0 domain.AnalyticsReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) (domain.AnalyticsReport, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) domain.AnalyticsReport); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.AnalyticsReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsService_GetAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalytics'
+type mockanalyticsService_GetAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsService_Expecter) GetAnalytics(ctx interface{}, filter interface{}) *mockanalyticsService_GetAnalytics_Call {
+	return &mockanalyticsService_GetAnalytics_Call{Call: _e.mock.On("GetAnalytics", ctx, filter)}
+}
+
+func (_c *mockanalyticsService_GetAnalytics_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsService_GetAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsService_GetAnalytics_Call) Return(analyticsReport domain.AnalyticsReport, err error) *mockanalyticsService_GetAnalytics_Call {
+	_c.Call.Return(analyticsReport, err)
+	return _c
+}
+
+func (_c *mockanalyticsService_GetAnalytics_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsReport, error)) *mockanalyticsService_GetAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCashflow provides a mock function for the type mockanalyticsService
+func (_mock *mockanalyticsService) GetCashflow(ctx context.Context, filter domain.AnalyticsFilter) (domain.CashflowReport, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCashflow")
+	}
+
+	var r0 domain.CashflowReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) (domain.CashflowReport, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) domain.CashflowReport); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.CashflowReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsService_GetCashflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCashflow'
+type mockanalyticsService_GetCashflow_Call struct {
+	*mock.Call
+}
+
+// GetCashflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsService_Expecter) GetCashflow(ctx interface{}, filter interface{}) *mockanalyticsService_GetCashflow_Call {
+	return &mockanalyticsService_GetCashflow_Call{Call: _e.mock.On("GetCashflow", ctx, filter)}
+}
+
+func (_c *mockanalyticsService_GetCashflow_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsService_GetCashflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsService_GetCashflow_Call) Return(cashflowReport domain.CashflowReport, err error) *mockanalyticsService_GetCashflow_Call {
+	_c.Call.Return(cashflowReport, err)
+	return _c
+}
+
+func (_c *mockanalyticsService_GetCashflow_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) (domain.CashflowReport, error)) *mockanalyticsService_GetCashflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockattachmentService creates a new instance of mockattachmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockattachmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockattachmentService {
+	mock := &mockattachmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockattachmentService is an autogenerated mock type for the attachmentService type
+type mockattachmentService struct {
+	mock.Mock
+}
+
+type mockattachmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockattachmentService) EXPECT() *mockattachmentService_Expecter {
+	return &mockattachmentService_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type mockattachmentService
+func (_mock *mockattachmentService) Delete(ctx context.Context, itemID string, id string) error {
+	ret := _mock.Called(ctx, itemID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, itemID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockattachmentService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockattachmentService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - id string
+func (_e *mockattachmentService_Expecter) Delete(ctx interface{}, itemID interface{}, id interface{}) *mockattachmentService_Delete_Call {
+	return &mockattachmentService_Delete_Call{Call: _e.mock.On("Delete", ctx, itemID, id)}
+}
+
+func (_c *mockattachmentService_Delete_Call) Run(run func(ctx context.Context, itemID string, id string)) *mockattachmentService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentService_Delete_Call) Return(err error) *mockattachmentService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockattachmentService_Delete_Call) RunAndReturn(run func(ctx context.Context, itemID string, id string) error) *mockattachmentService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type mockattachmentService
+func (_mock *mockattachmentService) List(ctx context.Context, itemID string) ([]domain.Attachment, error) {
+	ret := _mock.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Attachment, error)); ok {
+		return returnFunc(ctx, itemID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Attachment); ok {
+		r0 = returnFunc(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockattachmentService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockattachmentService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *mockattachmentService_Expecter) List(ctx interface{}, itemID interface{}) *mockattachmentService_List_Call {
+	return &mockattachmentService_List_Call{Call: _e.mock.On("List", ctx, itemID)}
+}
+
+func (_c *mockattachmentService_List_Call) Run(run func(ctx context.Context, itemID string)) *mockattachmentService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentService_List_Call) Return(attachments []domain.Attachment, err error) *mockattachmentService_List_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *mockattachmentService_List_Call) RunAndReturn(run func(ctx context.Context, itemID string) ([]domain.Attachment, error)) *mockattachmentService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function for the type mockattachmentService
+func (_mock *mockattachmentService) Open(ctx context.Context, itemID string, id string) (domain.Attachment, io.ReadCloser, error) {
+	ret := _mock.Called(ctx, itemID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 domain.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Attachment, io.ReadCloser, error)); ok {
+		return returnFunc(ctx, itemID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Attachment); ok {
+		r0 = returnFunc(ctx, itemID, id)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) io.ReadCloser); ok {
+		r1 = returnFunc(ctx, itemID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, itemID, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// mockattachmentService_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type mockattachmentService_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - id string
+func (_e *mockattachmentService_Expecter) Open(ctx interface{}, itemID interface{}, id interface{}) *mockattachmentService_Open_Call {
+	return &mockattachmentService_Open_Call{Call: _e.mock.On("Open", ctx, itemID, id)}
+}
+
+func (_c *mockattachmentService_Open_Call) Run(run func(ctx context.Context, itemID string, id string)) *mockattachmentService_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentService_Open_Call) Return(attachment domain.Attachment, readCloser io.ReadCloser, err error) *mockattachmentService_Open_Call {
+	_c.Call.Return(attachment, readCloser, err)
+	return _c
+}
+
+func (_c *mockattachmentService_Open_Call) RunAndReturn(run func(ctx context.Context, itemID string, id string) (domain.Attachment, io.ReadCloser, error)) *mockattachmentService_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upload provides a mock function for the type mockattachmentService
+func (_mock *mockattachmentService) Upload(ctx context.Context, itemID string, fileName string, size int64, content io.Reader) (domain.Attachment, error) {
+	ret := _mock.Called(ctx, itemID, fileName, size, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int64, io.Reader) (domain.Attachment, error)); ok {
+		return returnFunc(ctx, itemID, fileName, size, content)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int64, io.Reader) domain.Attachment); ok {
+		r0 = returnFunc(ctx, itemID, fileName, size, content)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int64, io.Reader) error); ok {
+		r1 = returnFunc(ctx, itemID, fileName, size, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockattachmentService_Upload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upload'
+type mockattachmentService_Upload_Call struct {
+	*mock.Call
+}
+
+// Upload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - fileName string
+//   - size int64
+//   - content io.Reader
+func (_e *mockattachmentService_Expecter) Upload(ctx interface{}, itemID interface{}, fileName interface{}, size interface{}, content interface{}) *mockattachmentService_Upload_Call {
+	return &mockattachmentService_Upload_Call{Call: _e.mock.On("Upload", ctx, itemID, fileName, size, content)}
+}
+
+func (_c *mockattachmentService_Upload_Call) Run(run func(ctx context.Context, itemID string, fileName string, size int64, content io.Reader)) *mockattachmentService_Upload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		var arg4 io.Reader
+		if args[4] != nil {
+			arg4 = args[4].(io.Reader)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentService_Upload_Call) Return(attachment domain.Attachment, err error) *mockattachmentService_Upload_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *mockattachmentService_Upload_Call) RunAndReturn(run func(ctx context.Context, itemID string, fileName string, size int64, content io.Reader) (domain.Attachment, error)) *mockattachmentService_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockcategoryService creates a new instance of mockcategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockcategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockcategoryService {
+	mock := &mockcategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockcategoryService is an autogenerated mock type for the categoryService type
+type mockcategoryService struct {
+	mock.Mock
+}
+
+type mockcategoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockcategoryService) EXPECT() *mockcategoryService_Expecter {
+	return &mockcategoryService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mockcategoryService
+func (_mock *mockcategoryService) Create(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	ret := _mock.Called(ctx, cat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) (domain.Category, error)); ok {
+		return returnFunc(ctx, cat)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) domain.Category); ok {
+		r0 = returnFunc(ctx, cat)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Category) error); ok {
+		r1 = returnFunc(ctx, cat)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockcategoryService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cat domain.Category
+func (_e *mockcategoryService_Expecter) Create(ctx interface{}, cat interface{}) *mockcategoryService_Create_Call {
+	return &mockcategoryService_Create_Call{Call: _e.mock.On("Create", ctx, cat)}
+}
+
+func (_c *mockcategoryService_Create_Call) Run(run func(ctx context.Context, cat domain.Category)) *mockcategoryService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryService_Create_Call) Return(category domain.Category, err error) *mockcategoryService_Create_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryService_Create_Call) RunAndReturn(run func(ctx context.Context, cat domain.Category) (domain.Category, error)) *mockcategoryService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockcategoryService
+func (_mock *mockcategoryService) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockcategoryService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockcategoryService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockcategoryService_Expecter) Delete(ctx interface{}, id interface{}) *mockcategoryService_Delete_Call {
+	return &mockcategoryService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockcategoryService_Delete_Call) Run(run func(ctx context.Context, id string)) *mockcategoryService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryService_Delete_Call) Return(err error) *mockcategoryService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockcategoryService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockcategoryService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockcategoryService
+func (_mock *mockcategoryService) GetByID(ctx context.Context, id string) (domain.Category, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Category, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Category); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockcategoryService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockcategoryService_Expecter) GetByID(ctx interface{}, id interface{}) *mockcategoryService_GetByID_Call {
+	return &mockcategoryService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockcategoryService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockcategoryService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryService_GetByID_Call) Return(category domain.Category, err error) *mockcategoryService_GetByID_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Category, error)) *mockcategoryService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type mockcategoryService
+func (_mock *mockcategoryService) List(ctx context.Context, includeArchived bool) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, includeArchived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, includeArchived)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []domain.Category); ok {
+		r0 = returnFunc(ctx, includeArchived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeArchived)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockcategoryService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeArchived bool
+func (_e *mockcategoryService_Expecter) List(ctx interface{}, includeArchived interface{}) *mockcategoryService_List_Call {
+	return &mockcategoryService_List_Call{Call: _e.mock.On("List", ctx, includeArchived)}
+}
+
+func (_c *mockcategoryService_List_Call) Run(run func(ctx context.Context, includeArchived bool)) *mockcategoryService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryService_List_Call) Return(categorys []domain.Category, err error) *mockcategoryService_List_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *mockcategoryService_List_Call) RunAndReturn(run func(ctx context.Context, includeArchived bool) ([]domain.Category, error)) *mockcategoryService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockcategoryService
+func (_mock *mockcategoryService) Update(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	ret := _mock.Called(ctx, cat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) (domain.Category, error)); ok {
+		return returnFunc(ctx, cat)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) domain.Category); ok {
+		r0 = returnFunc(ctx, cat)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Category) error); ok {
+		r1 = returnFunc(ctx, cat)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockcategoryService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cat domain.Category
+func (_e *mockcategoryService_Expecter) Update(ctx interface{}, cat interface{}) *mockcategoryService_Update_Call {
+	return &mockcategoryService_Update_Call{Call: _e.mock.On("Update", ctx, cat)}
+}
+
+func (_c *mockcategoryService_Update_Call) Run(run func(ctx context.Context, cat domain.Category)) *mockcategoryService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryService_Update_Call) Return(category domain.Category, err error) *mockcategoryService_Update_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryService_Update_Call) RunAndReturn(run func(ctx context.Context, cat domain.Category) (domain.Category, error)) *mockcategoryService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockexportItemService creates a new instance of mockexportItemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockexportItemService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockexportItemService {
+	mock := &mockexportItemService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockexportItemService is an autogenerated mock type for the exportItemService type
+type mockexportItemService struct {
+	mock.Mock
+}
+
+type mockexportItemService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockexportItemService) EXPECT() *mockexportItemService_Expecter {
+	return &mockexportItemService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function for the type mockexportItemService
+func (_mock *mockexportItemService) List(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 domain.ItemPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) (domain.ItemPage, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) domain.ItemPage); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.ItemPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockexportItemService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockexportItemService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.ItemFilter
+func (_e *mockexportItemService_Expecter) List(ctx interface{}, filter interface{}) *mockexportItemService_List_Call {
+	return &mockexportItemService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *mockexportItemService_List_Call) Run(run func(ctx context.Context, filter domain.ItemFilter)) *mockexportItemService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ItemFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.ItemFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockexportItemService_List_Call) Return(itemPage domain.ItemPage, err error) *mockexportItemService_List_Call {
+	_c.Call.Return(itemPage, err)
+	return _c
+}
+
+func (_c *mockexportItemService_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error)) *mockexportItemService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockimportItemService creates a new instance of mockimportItemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockimportItemService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockimportItemService {
+	mock := &mockimportItemService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockimportItemService is an autogenerated mock type for the importItemService type
+type mockimportItemService struct {
+	mock.Mock
+}
+
+type mockimportItemService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockimportItemService) EXPECT() *mockimportItemService_Expecter {
+	return &mockimportItemService_Expecter{mock: &_m.Mock}
+}
+
+// CheckCategories provides a mock function for the type mockimportItemService
+func (_mock *mockimportItemService) CheckCategories(ctx context.Context, items []domain.Item) ([]error, error) {
+	ret := _mock.Called(ctx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckCategories")
+	}
+
+	var r0 []error
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Item) ([]error, error)); ok {
+		return returnFunc(ctx, items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Item) []error); ok {
+		r0 = returnFunc(ctx, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Item) error); ok {
+		r1 = returnFunc(ctx, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockimportItemService_CheckCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckCategories'
+type mockimportItemService_CheckCategories_Call struct {
+	*mock.Call
+}
+
+// CheckCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items []domain.Item
+func (_e *mockimportItemService_Expecter) CheckCategories(ctx interface{}, items interface{}) *mockimportItemService_CheckCategories_Call {
+	return &mockimportItemService_CheckCategories_Call{Call: _e.mock.On("CheckCategories", ctx, items)}
+}
+
+func (_c *mockimportItemService_CheckCategories_Call) Run(run func(ctx context.Context, items []domain.Item)) *mockimportItemService_CheckCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Item
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockimportItemService_CheckCategories_Call) Return(errs []error, err error) *mockimportItemService_CheckCategories_Call {
+	_c.Call.Return(errs, err)
+	return _c
+}
+
+func (_c *mockimportItemService_CheckCategories_Call) RunAndReturn(run func(ctx context.Context, items []domain.Item) ([]error, error)) *mockimportItemService_CheckCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function for the type mockimportItemService
+func (_mock *mockimportItemService) Import(ctx context.Context, items []domain.Item) ([]domain.Item, error) {
+	ret := _mock.Called(ctx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 []domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Item) ([]domain.Item, error)); ok {
+		return returnFunc(ctx, items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Item) []domain.Item); ok {
+		r0 = returnFunc(ctx, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Item)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Item) error); ok {
+		r1 = returnFunc(ctx, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockimportItemService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type mockimportItemService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items []domain.Item
+func (_e *mockimportItemService_Expecter) Import(ctx interface{}, items interface{}) *mockimportItemService_Import_Call {
+	return &mockimportItemService_Import_Call{Call: _e.mock.On("Import", ctx, items)}
+}
+
+func (_c *mockimportItemService_Import_Call) Run(run func(ctx context.Context, items []domain.Item)) *mockimportItemService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Item
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockimportItemService_Import_Call) Return(items1 []domain.Item, err error) *mockimportItemService_Import_Call {
+	_c.Call.Return(items1, err)
+	return _c
+}
+
+func (_c *mockimportItemService_Import_Call) RunAndReturn(run func(ctx context.Context, items []domain.Item) ([]domain.Item, error)) *mockimportItemService_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockitemService creates a new instance of mockitemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockitemService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockitemService {
+	mock := &mockitemService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockitemService is an autogenerated mock type for the itemService type
+type mockitemService struct {
+	mock.Mock
+}
+
+type mockitemService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockitemService) EXPECT() *mockitemService_Expecter {
+	return &mockitemService_Expecter{mock: &_m.Mock}
+}
+
+// Batch provides a mock function for the type mockitemService
+func (_mock *mockitemService) Batch(ctx context.Context, ops []domain.BatchOperation, atomic bool) ([]domain.BatchResult, error) {
+	ret := _mock.Called(ctx, ops, atomic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 []domain.BatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.BatchOperation, bool) ([]domain.BatchResult, error)); ok {
+		return returnFunc(ctx, ops, atomic)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.BatchOperation, bool) []domain.BatchResult); ok {
+		r0 = returnFunc(ctx, ops, atomic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BatchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.BatchOperation, bool) error); ok {
+		r1 = returnFunc(ctx, ops, atomic)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type mockitemService_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ops []domain.BatchOperation
+//   - atomic bool
+func (_e *mockitemService_Expecter) Batch(ctx interface{}, ops interface{}, atomic interface{}) *mockitemService_Batch_Call {
+	return &mockitemService_Batch_Call{Call: _e.mock.On("Batch", ctx, ops, atomic)}
+}
+
+func (_c *mockitemService_Batch_Call) Run(run func(ctx context.Context, ops []domain.BatchOperation, atomic bool)) *mockitemService_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.BatchOperation
+		if args[1] != nil {
+			arg1 = args[1].([]domain.BatchOperation)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Batch_Call) Return(batchResults []domain.BatchResult, err error) *mockitemService_Batch_Call {
+	_c.Call.Return(batchResults, err)
+	return _c
+}
+
+func (_c *mockitemService_Batch_Call) RunAndReturn(run func(ctx context.Context, ops []domain.BatchOperation, atomic bool) ([]domain.BatchResult, error)) *mockitemService_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type mockitemService
+func (_mock *mockitemService) Create(ctx context.Context, item domain.Item) (domain.Item, error) {
+	ret := _mock.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
+		return returnFunc(ctx, item)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
+		r0 = returnFunc(ctx, item)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
+		r1 = returnFunc(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockitemService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item domain.Item
+func (_e *mockitemService_Expecter) Create(ctx interface{}, item interface{}) *mockitemService_Create_Call {
+	return &mockitemService_Create_Call{Call: _e.mock.On("Create", ctx, item)}
+}
+
+func (_c *mockitemService_Create_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Item
+		if args[1] != nil {
+			arg1 = args[1].(domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Create_Call) Return(item1 domain.Item, err error) *mockitemService_Create_Call {
+	_c.Call.Return(item1, err)
+	return _c
+}
+
+func (_c *mockitemService_Create_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockitemService
+func (_mock *mockitemService) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockitemService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockitemService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemService_Expecter) Delete(ctx interface{}, id interface{}) *mockitemService_Delete_Call {
+	return &mockitemService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockitemService_Delete_Call) Run(run func(ctx context.Context, id string)) *mockitemService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Delete_Call) Return(err error) *mockitemService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockitemService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockitemService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockitemService
+func (_mock *mockitemService) GetByID(ctx context.Context, id string) (domain.Item, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Item, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Item); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockitemService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemService_Expecter) GetByID(ctx interface{}, id interface{}) *mockitemService_GetByID_Call {
+	return &mockitemService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockitemService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockitemService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_GetByID_Call) Return(item domain.Item, err error) *mockitemService_GetByID_Call {
+	_c.Call.Return(item, err)
+	return _c
+}
+
+func (_c *mockitemService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Item, error)) *mockitemService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function for the type mockitemService
+func (_mock *mockitemService) History(ctx context.Context, id string) ([]domain.ItemHistoryEntry, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []domain.ItemHistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.ItemHistoryEntry, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.ItemHistoryEntry); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ItemHistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type mockitemService_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemService_Expecter) History(ctx interface{}, id interface{}) *mockitemService_History_Call {
+	return &mockitemService_History_Call{Call: _e.mock.On("History", ctx, id)}
+}
+
+func (_c *mockitemService_History_Call) Run(run func(ctx context.Context, id string)) *mockitemService_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_History_Call) Return(itemHistoryEntrys []domain.ItemHistoryEntry, err error) *mockitemService_History_Call {
+	_c.Call.Return(itemHistoryEntrys, err)
+	return _c
+}
+
+func (_c *mockitemService_History_Call) RunAndReturn(run func(ctx context.Context, id string) ([]domain.ItemHistoryEntry, error)) *mockitemService_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type mockitemService
+func (_mock *mockitemService) List(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 domain.ItemPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) (domain.ItemPage, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) domain.ItemPage); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.ItemPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockitemService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.ItemFilter
+func (_e *mockitemService_Expecter) List(ctx interface{}, filter interface{}) *mockitemService_List_Call {
+	return &mockitemService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *mockitemService_List_Call) Run(run func(ctx context.Context, filter domain.ItemFilter)) *mockitemService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ItemFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.ItemFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_List_Call) Return(itemPage domain.ItemPage, err error) *mockitemService_List_Call {
+	_c.Call.Return(itemPage, err)
+	return _c
+}
+
+func (_c *mockitemService_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error)) *mockitemService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type mockitemService
+func (_mock *mockitemService) Patch(ctx context.Context, patch domain.ItemPatch) (domain.Item, error) {
+	ret := _mock.Called(ctx, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemPatch) (domain.Item, error)); ok {
+		return returnFunc(ctx, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemPatch) domain.Item); ok {
+		r0 = returnFunc(ctx, patch)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemPatch) error); ok {
+		r1 = returnFunc(ctx, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type mockitemService_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - patch domain.ItemPatch
+func (_e *mockitemService_Expecter) Patch(ctx interface{}, patch interface{}) *mockitemService_Patch_Call {
+	return &mockitemService_Patch_Call{Call: _e.mock.On("Patch", ctx, patch)}
+}
+
+func (_c *mockitemService_Patch_Call) Run(run func(ctx context.Context, patch domain.ItemPatch)) *mockitemService_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ItemPatch
+		if args[1] != nil {
+			arg1 = args[1].(domain.ItemPatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Patch_Call) Return(item domain.Item, err error) *mockitemService_Patch_Call {
+	_c.Call.Return(item, err)
+	return _c
+}
+
+func (_c *mockitemService_Patch_Call) RunAndReturn(run func(ctx context.Context, patch domain.ItemPatch) (domain.Item, error)) *mockitemService_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type mockitemService
+func (_mock *mockitemService) Purge(ctx context.Context, olderThanDays int) (int64, error) {
+	ret := _mock.Called(ctx, olderThanDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return returnFunc(ctx, olderThanDays)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = returnFunc(ctx, olderThanDays)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, olderThanDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type mockitemService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThanDays int
+func (_e *mockitemService_Expecter) Purge(ctx interface{}, olderThanDays interface{}) *mockitemService_Purge_Call {
+	return &mockitemService_Purge_Call{Call: _e.mock.On("Purge", ctx, olderThanDays)}
+}
+
+func (_c *mockitemService_Purge_Call) Run(run func(ctx context.Context, olderThanDays int)) *mockitemService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Purge_Call) Return(n int64, err error) *mockitemService_Purge_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *mockitemService_Purge_Call) RunAndReturn(run func(ctx context.Context, olderThanDays int) (int64, error)) *mockitemService_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type mockitemService
+func (_mock *mockitemService) Restore(ctx context.Context, id string) (domain.Item, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Item, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Item); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type mockitemService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemService_Expecter) Restore(ctx interface{}, id interface{}) *mockitemService_Restore_Call {
+	return &mockitemService_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *mockitemService_Restore_Call) Run(run func(ctx context.Context, id string)) *mockitemService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Restore_Call) Return(item domain.Item, err error) *mockitemService_Restore_Call {
+	_c.Call.Return(item, err)
+	return _c
+}
+
+func (_c *mockitemService_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Item, error)) *mockitemService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockitemService
+func (_mock *mockitemService) Update(ctx context.Context, item domain.Item) (domain.Item, error) {
+	ret := _mock.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
+		return returnFunc(ctx, item)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
+		r0 = returnFunc(ctx, item)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
+		r1 = returnFunc(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockitemService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item domain.Item
+func (_e *mockitemService_Expecter) Update(ctx interface{}, item interface{}) *mockitemService_Update_Call {
+	return &mockitemService_Update_Call{Call: _e.mock.On("Update", ctx, item)}
+}
+
+func (_c *mockitemService_Update_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Item
+		if args[1] != nil {
+			arg1 = args[1].(domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemService_Update_Call) Return(item1 domain.Item, err error) *mockitemService_Update_Call {
+	_c.Call.Return(item1, err)
+	return _c
+}
+
+func (_c *mockitemService_Update_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockrateService creates a new instance of mockrateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockrateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockrateService {
+	mock := &mockrateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockrateService is an autogenerated mock type for the rateService type
+type mockrateService struct {
+	mock.Mock
+}
+
+type mockrateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockrateService) EXPECT() *mockrateService_Expecter {
+	return &mockrateService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mockrateService
+func (_mock *mockrateService) Create(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) (domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, rate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, rate)
+	} else {
+		r0 = ret.Get(0).(domain.ExchangeRate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ExchangeRate) error); ok {
+		r1 = returnFunc(ctx, rate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockrateService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate domain.ExchangeRate
+func (_e *mockrateService_Expecter) Create(ctx interface{}, rate interface{}) *mockrateService_Create_Call {
+	return &mockrateService_Create_Call{Call: _e.mock.On("Create", ctx, rate)}
+}
+
+func (_c *mockrateService_Create_Call) Run(run func(ctx context.Context, rate domain.ExchangeRate)) *mockrateService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateService_Create_Call) Return(exchangeRate domain.ExchangeRate, err error) *mockrateService_Create_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *mockrateService_Create_Call) RunAndReturn(run func(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)) *mockrateService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockrateService
+func (_mock *mockrateService) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockrateService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockrateService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrateService_Expecter) Delete(ctx interface{}, id interface{}) *mockrateService_Delete_Call {
+	return &mockrateService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockrateService_Delete_Call) Run(run func(ctx context.Context, id string)) *mockrateService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateService_Delete_Call) Return(err error) *mockrateService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockrateService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockrateService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockrateService
+func (_mock *mockrateService) GetByID(ctx context.Context, id string) (domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.ExchangeRate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockrateService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrateService_Expecter) GetByID(ctx interface{}, id interface{}) *mockrateService_GetByID_Call {
+	return &mockrateService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockrateService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockrateService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateService_GetByID_Call) Return(exchangeRate domain.ExchangeRate, err error) *mockrateService_GetByID_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *mockrateService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.ExchangeRate, error)) *mockrateService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function for the type mockrateService
+func (_mock *mockrateService) Import(ctx context.Context, rates []domain.ExchangeRate) (int, error) {
+	ret := _mock.Called(ctx, rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ExchangeRate) (int, error)); ok {
+		return returnFunc(ctx, rates)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ExchangeRate) int); ok {
+		r0 = returnFunc(ctx, rates)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.ExchangeRate) error); ok {
+		r1 = returnFunc(ctx, rates)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type mockrateService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rates []domain.ExchangeRate
+func (_e *mockrateService_Expecter) Import(ctx interface{}, rates interface{}) *mockrateService_Import_Call {
+	return &mockrateService_Import_Call{Call: _e.mock.On("Import", ctx, rates)}
+}
+
+func (_c *mockrateService_Import_Call) Run(run func(ctx context.Context, rates []domain.ExchangeRate)) *mockrateService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].([]domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateService_Import_Call) Return(n int, err error) *mockrateService_Import_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *mockrateService_Import_Call) RunAndReturn(run func(ctx context.Context, rates []domain.ExchangeRate) (int, error)) *mockrateService_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type mockrateService
+func (_mock *mockrateService) List(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RateFilter) ([]domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RateFilter) []domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.RateFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockrateService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.RateFilter
+func (_e *mockrateService_Expecter) List(ctx interface{}, filter interface{}) *mockrateService_List_Call {
+	return &mockrateService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *mockrateService_List_Call) Run(run func(ctx context.Context, filter domain.RateFilter)) *mockrateService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.RateFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.RateFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateService_List_Call) Return(exchangeRates []domain.ExchangeRate, err error) *mockrateService_List_Call {
+	_c.Call.Return(exchangeRates, err)
+	return _c
+}
+
+func (_c *mockrateService_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error)) *mockrateService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockrateService
+func (_mock *mockrateService) Update(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) (domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, rate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, rate)
+	} else {
+		r0 = ret.Get(0).(domain.ExchangeRate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ExchangeRate) error); ok {
+		r1 = returnFunc(ctx, rate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockrateService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate domain.ExchangeRate
+func (_e *mockrateService_Expecter) Update(ctx interface{}, rate interface{}) *mockrateService_Update_Call {
+	return &mockrateService_Update_Call{Call: _e.mock.On("Update", ctx, rate)}
+}
+
+func (_c *mockrateService_Update_Call) Run(run func(ctx context.Context, rate domain.ExchangeRate)) *mockrateService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateService_Update_Call) Return(exchangeRate domain.ExchangeRate, err error) *mockrateService_Update_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *mockrateService_Update_Call) RunAndReturn(run func(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)) *mockrateService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockrecurringService creates a new instance of mockrecurringService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockrecurringService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockrecurringService {
+	mock := &mockrecurringService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockrecurringService is an autogenerated mock type for the recurringService type
+type mockrecurringService struct {
+	mock.Mock
+}
+
+type mockrecurringService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockrecurringService) EXPECT() *mockrecurringService_Expecter {
+	return &mockrecurringService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) Create(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RecurringItem) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RecurringItem) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.RecurringItem) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockrecurringService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec domain.RecurringItem
+func (_e *mockrecurringService_Expecter) Create(ctx interface{}, rec interface{}) *mockrecurringService_Create_Call {
+	return &mockrecurringService_Create_Call{Call: _e.mock.On("Create", ctx, rec)}
+}
+
+func (_c *mockrecurringService_Create_Call) Run(run func(ctx context.Context, rec domain.RecurringItem)) *mockrecurringService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.RecurringItem
+		if args[1] != nil {
+			arg1 = args[1].(domain.RecurringItem)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_Create_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringService_Create_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringService_Create_Call) RunAndReturn(run func(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error)) *mockrecurringService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockrecurringService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockrecurringService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrecurringService_Expecter) Delete(ctx interface{}, id interface{}) *mockrecurringService_Delete_Call {
+	return &mockrecurringService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockrecurringService_Delete_Call) Run(run func(ctx context.Context, id string)) *mockrecurringService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_Delete_Call) Return(err error) *mockrecurringService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockrecurringService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockrecurringService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) GetByID(ctx context.Context, id string) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockrecurringService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrecurringService_Expecter) GetByID(ctx interface{}, id interface{}) *mockrecurringService_GetByID_Call {
+	return &mockrecurringService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockrecurringService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockrecurringService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_GetByID_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringService_GetByID_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.RecurringItem, error)) *mockrecurringService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) List(ctx context.Context) ([]domain.RecurringItem, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.RecurringItem, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.RecurringItem); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RecurringItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockrecurringService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockrecurringService_Expecter) List(ctx interface{}) *mockrecurringService_List_Call {
+	return &mockrecurringService_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *mockrecurringService_List_Call) Run(run func(ctx context.Context)) *mockrecurringService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_List_Call) Return(recurringItems []domain.RecurringItem, err error) *mockrecurringService_List_Call {
+	_c.Call.Return(recurringItems, err)
+	return _c
+}
+
+func (_c *mockrecurringService_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.RecurringItem, error)) *mockrecurringService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pause provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) Pause(ctx context.Context, id string) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pause")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringService_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type mockrecurringService_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrecurringService_Expecter) Pause(ctx interface{}, id interface{}) *mockrecurringService_Pause_Call {
+	return &mockrecurringService_Pause_Call{Call: _e.mock.On("Pause", ctx, id)}
+}
+
+func (_c *mockrecurringService_Pause_Call) Run(run func(ctx context.Context, id string)) *mockrecurringService_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_Pause_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringService_Pause_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringService_Pause_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.RecurringItem, error)) *mockrecurringService_Pause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preview provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) Preview(ctx context.Context, id string, count int) ([]time.Time, error) {
+	ret := _mock.Called(ctx, id, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preview")
+	}
+
+	var r0 []time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]time.Time, error)); ok {
+		return returnFunc(ctx, id, count)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []time.Time); ok {
+		r0 = returnFunc(ctx, id, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, id, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringService_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type mockrecurringService_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - count int
+func (_e *mockrecurringService_Expecter) Preview(ctx interface{}, id interface{}, count interface{}) *mockrecurringService_Preview_Call {
+	return &mockrecurringService_Preview_Call{Call: _e.mock.On("Preview", ctx, id, count)}
+}
+
+func (_c *mockrecurringService_Preview_Call) Run(run func(ctx context.Context, id string, count int)) *mockrecurringService_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_Preview_Call) Return(times []time.Time, err error) *mockrecurringService_Preview_Call {
+	_c.Call.Return(times, err)
+	return _c
+}
+
+func (_c *mockrecurringService_Preview_Call) RunAndReturn(run func(ctx context.Context, id string, count int) ([]time.Time, error)) *mockrecurringService_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function for the type mockrecurringService
+func (_mock *mockrecurringService) Resume(ctx context.Context, id string, now time.Time) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, id, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, id, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, id, now)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringService_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type mockrecurringService_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - now time.Time
+func (_e *mockrecurringService_Expecter) Resume(ctx interface{}, id interface{}, now interface{}) *mockrecurringService_Resume_Call {
+	return &mockrecurringService_Resume_Call{Call: _e.mock.On("Resume", ctx, id, now)}
+}
+
+func (_c *mockrecurringService_Resume_Call) Run(run func(ctx context.Context, id string, now time.Time)) *mockrecurringService_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringService_Resume_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringService_Resume_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringService_Resume_Call) RunAndReturn(run func(ctx context.Context, id string, now time.Time) (domain.RecurringItem, error)) *mockrecurringService_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMocktransferService creates a new instance of mocktransferService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMocktransferService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocktransferService {
+	mock := &mocktransferService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mocktransferService is an autogenerated mock type for the transferService type
+type mocktransferService struct {
+	mock.Mock
+}
+
+type mocktransferService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mocktransferService) EXPECT() *mocktransferService_Expecter {
+	return &mocktransferService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mocktransferService
+func (_mock *mocktransferService) Create(ctx context.Context, p domain.TransferParams) (domain.Transfer, error) {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TransferParams) (domain.Transfer, error)); ok {
+		return returnFunc(ctx, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TransferParams) domain.Transfer); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Get(0).(domain.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.TransferParams) error); ok {
+		r1 = returnFunc(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocktransferService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mocktransferService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p domain.TransferParams
+func (_e *mocktransferService_Expecter) Create(ctx interface{}, p interface{}) *mocktransferService_Create_Call {
+	return &mocktransferService_Create_Call{Call: _e.mock.On("Create", ctx, p)}
+}
+
+func (_c *mocktransferService_Create_Call) Run(run func(ctx context.Context, p domain.TransferParams)) *mocktransferService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.TransferParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.TransferParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mocktransferService_Create_Call) Return(transfer domain.Transfer, err error) *mocktransferService_Create_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *mocktransferService_Create_Call) RunAndReturn(run func(ctx context.Context, p domain.TransferParams) (domain.Transfer, error)) *mocktransferService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mocktransferService
+func (_mock *mocktransferService) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mocktransferService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mocktransferService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mocktransferService_Expecter) Delete(ctx interface{}, id interface{}) *mocktransferService_Delete_Call {
+	return &mocktransferService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mocktransferService_Delete_Call) Run(run func(ctx context.Context, id string)) *mocktransferService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mocktransferService_Delete_Call) Return(err error) *mocktransferService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mocktransferService_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mocktransferService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mocktransferService
+func (_mock *mocktransferService) GetByID(ctx context.Context, id string) (domain.Transfer, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Transfer, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Transfer); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocktransferService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mocktransferService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mocktransferService_Expecter) GetByID(ctx interface{}, id interface{}) *mocktransferService_GetByID_Call {
+	return &mocktransferService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mocktransferService_GetByID_Call) Run(run func(ctx context.Context, id string)) *mocktransferService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mocktransferService_GetByID_Call) Return(transfer domain.Transfer, err error) *mocktransferService_GetByID_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *mocktransferService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Transfer, error)) *mocktransferService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stpnv0/SalesTracker/internal/importer"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+type rateService interface {
+	Create(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)
+	GetByID(ctx context.Context, id string) (domain.ExchangeRate, error)
+	List(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error)
+	Update(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)
+	Delete(ctx context.Context, id string) error
+	Import(ctx context.Context, rates []domain.ExchangeRate) (int, error)
+}
+
+type RateHandler struct {
+	svc rateService
+	log logger.Logger
+}
+
+func NewRateHandler(svc rateService, log logger.Logger) *RateHandler {
+	return &RateHandler{
+		svc: svc,
+		log: log,
+	}
+}
+
+// Create - POST /api/rates.
+func (h *RateHandler) Create(c *ginext.Context) {
+	var req ExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rate, err := req.ToRate("")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	created, err := h.svc.Create(c.Request.Context(), rate)
+	if err != nil {
+		if errors.Is(err, domain.ErrRateExists) {
+			respondError(c, http.StatusConflict, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "create exchange rate",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusCreated, created)
+}
+
+// List - GET /api/rates.
+func (h *RateHandler) List(c *ginext.Context) {
+	filter, err := parseRateFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rates, err := h.svc.List(c.Request.Context(), filter)
+	if err != nil {
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "list exchange rates",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if rates == nil {
+		rates = []domain.ExchangeRate{}
+	}
+	respondJSON(c, http.StatusOK, map[string]interface{}{"rates": rates})
+}
+
+// GetByID - GET /api/rates/:id.
+func (h *RateHandler) GetByID(c *ginext.Context) {
+	id := c.Param("id")
+
+	rate, err := h.svc.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRateNotFound) {
+			respondError(c, http.StatusNotFound, "exchange rate not found")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidID) {
+			respondError(c, http.StatusBadRequest, "invalid exchange rate id")
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "get exchange rate by id",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, rate)
+}
+
+// Update - PUT /api/rates/:id.
+func (h *RateHandler) Update(c *ginext.Context) {
+	id := c.Param("id")
+
+	var req ExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rate, err := req.ToRate(id)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated, err := h.svc.Update(c.Request.Context(), rate)
+	if err != nil {
+		if errors.Is(err, domain.ErrRateNotFound) {
+			respondError(c, http.StatusNotFound, "exchange rate not found")
+			return
+		}
+		if errors.Is(err, domain.ErrRateExists) {
+			respondError(c, http.StatusConflict, err.Error())
+			return
+		}
+		if domain.IsValidationError(err) {
+			respondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "update exchange rate",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, updated)
+}
+
+// Delete - DELETE /api/rates/:id.
+func (h *RateHandler) Delete(c *ginext.Context) {
+	id := c.Param("id")
+
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, domain.ErrRateNotFound) {
+			respondError(c, http.StatusNotFound, "exchange rate not found")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidID) {
+			respondError(c, http.StatusBadRequest, "invalid exchange rate id")
+			return
+		}
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "delete exchange rate",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondNoContent(c)
+}
+
+// Import - POST /api/rates/import (multipart, поле file: CSV date,from,to,rate).
+func (h *RateHandler) Import(c *ginext.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "multipart field 'file' is required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "cannot read uploaded file")
+		return
+	}
+	defer file.Close()
+
+	rates, err := importer.ParseRatesCSV(file)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	imported, err := h.svc.Import(c.Request.Context(), rates)
+	if err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "import exchange rates",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, map[string]interface{}{"imported": imported})
+}
+
+func parseRateFilter(c *ginext.Context) (domain.RateFilter, error) {
+	var filter domain.RateFilter
+
+	if v := c.Query("from"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return filter, errors.New("invalid 'from' date format, expected YYYY-MM-DD")
+		}
+		filter.From = &t
+	}
+	if v := c.Query("to"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return filter, errors.New("invalid 'to' date format, expected YYYY-MM-DD")
+		}
+		filter.To = &t
+	}
+	filter.FromCurrency = strings.ToUpper(c.Query("from_currency"))
+	filter.ToCurrency = strings.ToUpper(c.Query("to_currency"))
+
+	return filter, nil
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRateRouter(h *RateHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/rates", gin.HandlerFunc(h.Create))
+	r.POST("/api/rates/import", gin.HandlerFunc(h.Import))
+	r.GET("/api/rates", gin.HandlerFunc(h.List))
+	r.GET("/api/rates/:id", gin.HandlerFunc(h.GetByID))
+	r.PUT("/api/rates/:id", gin.HandlerFunc(h.Update))
+	r.DELETE("/api/rates/:id", gin.HandlerFunc(h.Delete))
+	return r
+}
+
+func testRate() domain.ExchangeRate {
+	return domain.ExchangeRate{
+		ID:   testItemID(),
+		Date: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		From: "USD",
+		To:   "RUB",
+		Rate: decimal.RequireFromString("90.5"),
+	}
+}
+
+func newCSVUpload(t *testing.T, url, content string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", "upload.csv")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, url, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req
+}
+
+func TestRateHandler_Create_Success(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r domain.ExchangeRate) bool {
+		return r.From == "USD" && r.To == "RUB" && r.Rate.Equal(decimal.RequireFromString("90.5"))
+	})).Return(testRate(), nil)
+
+	body := `{"date":"2026-02-01","from":"USD","to":"RUB","rate":90.5}`
+	req := httptest.NewRequest(http.MethodPost, "/api/rates", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestRateHandler_Create_SameCurrency(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	body := `{"date":"2026-02-01","from":"RUB","to":"RUB","rate":1}`
+	req := httptest.NewRequest(http.MethodPost, "/api/rates", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRateHandler_Create_Conflict(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.Anything).Return(domain.ExchangeRate{}, domain.ErrRateExists)
+
+	body := `{"date":"2026-02-01","from":"USD","to":"RUB","rate":90.5}`
+	req := httptest.NewRequest(http.MethodPost, "/api/rates", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestRateHandler_List_Success(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	svc.EXPECT().List(mock.Anything, mock.MatchedBy(func(f domain.RateFilter) bool {
+		return f.FromCurrency == "USD"
+	})).Return([]domain.ExchangeRate{testRate()}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/rates?from_currency=usd", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string][]domain.ExchangeRate
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp["rates"], 1)
+}
+
+func TestRateHandler_GetByID_NotFound(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	svc.EXPECT().GetByID(mock.Anything, testItemID()).Return(domain.ExchangeRate{}, domain.ErrRateNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/rates/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestRateHandler_Delete_Success(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	svc.EXPECT().Delete(mock.Anything, testItemID()).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/rates/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestRateHandler_Import_Success(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	svc.EXPECT().Import(mock.Anything, mock.MatchedBy(func(rates []domain.ExchangeRate) bool {
+		return len(rates) == 2
+	})).Return(2, nil)
+
+	req := newCSVUpload(t, "/api/rates/import", "date,from,to,rate\n2026-02-01,USD,RUB,90.5\n2026-02-02,USD,RUB,91\n")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"imported":2}`, w.Body.String())
+}
+
+func TestRateHandler_Import_InvalidRow(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	req := newCSVUpload(t, "/api/rates/import", "date,from,to,rate\n2026-02-01,USD,RUB,-1\n")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "line 2")
+}
+
+func TestRateHandler_Import_MissingFile(t *testing.T) {
+	svc := newMockrateService(t)
+	h := NewRateHandler(svc, newTestLogger(t))
+	router := setupRateRouter(h)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/rates/import", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
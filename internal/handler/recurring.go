@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+const defaultPreviewCount = 5
+
+type recurringService interface {
+	Create(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error)
+	List(ctx context.Context) ([]domain.RecurringItem, error)
+	GetByID(ctx context.Context, id string) (domain.RecurringItem, error)
+	Pause(ctx context.Context, id string) (domain.RecurringItem, error)
+	Resume(ctx context.Context, id string, now time.Time) (domain.RecurringItem, error)
+	Delete(ctx context.Context, id string) error
+	Preview(ctx context.Context, id string, count int) ([]time.Time, error)
+}
+
+type RecurringHandler struct {
+	svc recurringService
+	log logger.Logger
+}
+
+func NewRecurringHandler(svc recurringService, log logger.Logger) *RecurringHandler {
+	return &RecurringHandler{
+		svc: svc,
+		log: log,
+	}
+}
+
+// Create - POST /api/recurring.
+func (h *RecurringHandler) Create(c *ginext.Context) {
+	var req RecurringItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rec, err := req.ToRecurringItem()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	created, err := h.svc.Create(c.Request.Context(), rec)
+	if err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "create recurring item",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	respondJSON(c, http.StatusCreated, created)
+}
+
+// List - GET /api/recurring.
+func (h *RecurringHandler) List(c *ginext.Context) {
+	recs, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, "list recurring items",
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if recs == nil {
+		recs = []domain.RecurringItem{}
+	}
+	respondJSON(c, http.StatusOK, map[string]interface{}{"recurring": recs})
+}
+
+// GetByID - GET /api/recurring/:id.
+func (h *RecurringHandler) GetByID(c *ginext.Context) {
+	rec, err := h.svc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondRecurringError(c, err, "get recurring item")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, rec)
+}
+
+// Pause - POST /api/recurring/:id/pause.
+func (h *RecurringHandler) Pause(c *ginext.Context) {
+	rec, err := h.svc.Pause(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondRecurringError(c, err, "pause recurring item")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, rec)
+}
+
+// Resume - POST /api/recurring/:id/resume.
+func (h *RecurringHandler) Resume(c *ginext.Context) {
+	rec, err := h.svc.Resume(c.Request.Context(), c.Param("id"), time.Now())
+	if err != nil {
+		h.respondRecurringError(c, err, "resume recurring item")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, rec)
+}
+
+// Delete - DELETE /api/recurring/:id.
+func (h *RecurringHandler) Delete(c *ginext.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondRecurringError(c, err, "delete recurring item")
+		return
+	}
+
+	respondNoContent(c)
+}
+
+// Preview - GET /api/recurring/:id/preview?count=N.
+func (h *RecurringHandler) Preview(c *ginext.Context) {
+	count := defaultPreviewCount
+	if v := c.Query("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, domain.ErrInvalidPreviewCount.Error())
+			return
+		}
+		count = n
+	}
+
+	dates, err := h.svc.Preview(c.Request.Context(), c.Param("id"), count)
+	if err != nil {
+		h.respondRecurringError(c, err, "preview recurring item")
+		return
+	}
+
+	occurrences := make([]string, 0, len(dates))
+	for _, d := range dates {
+		occurrences = append(occurrences, d.Format("2006-01-02"))
+	}
+	respondJSON(c, http.StatusOK, map[string]interface{}{"occurrences": occurrences})
+}
+
+func (h *RecurringHandler) respondRecurringError(c *ginext.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrRecurringNotFound):
+		respondError(c, http.StatusNotFound, "recurring item not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		respondError(c, http.StatusBadRequest, "invalid recurring item id")
+	case domain.IsValidationError(err):
+		respondError(c, http.StatusBadRequest, err.Error())
+	default:
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, msg,
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+	}
+}
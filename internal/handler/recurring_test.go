@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRecurringRouter(h *RecurringHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/recurring", gin.HandlerFunc(h.Create))
+	r.GET("/api/recurring", gin.HandlerFunc(h.List))
+	r.GET("/api/recurring/:id", gin.HandlerFunc(h.GetByID))
+	r.DELETE("/api/recurring/:id", gin.HandlerFunc(h.Delete))
+	r.POST("/api/recurring/:id/pause", gin.HandlerFunc(h.Pause))
+	r.POST("/api/recurring/:id/resume", gin.HandlerFunc(h.Resume))
+	r.GET("/api/recurring/:id/preview", gin.HandlerFunc(h.Preview))
+	return r
+}
+
+func testRecurring() domain.RecurringItem {
+	return domain.RecurringItem{
+		ID:        testItemID(),
+		Type:      domain.TypeExpense,
+		Amount:    decimal.NewFromInt(1500),
+		Currency:  "RUB",
+		Category:  "rent",
+		Frequency: domain.FrequencyMonthly,
+		Interval:  1,
+		StartDate: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRecurringHandler_Create_Success(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r domain.RecurringItem) bool {
+		return r.Frequency == domain.FrequencyMonthly && r.Interval == 1 && r.Currency == "RUB"
+	})).Return(testRecurring(), nil)
+
+	body := `{"type":"expense","amount":1500,"category":"rent","frequency":"monthly","start_date":"2026-01-31"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/recurring", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestRecurringHandler_Create_InvalidFrequency(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	body := `{"type":"expense","amount":1500,"category":"rent","frequency":"hourly","start_date":"2026-01-31"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/recurring", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRecurringHandler_Create_InvalidInterval(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	body := `{"type":"expense","amount":1500,"category":"rent","frequency":"monthly",` +
+		`"interval":-1,"start_date":"2026-01-31"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/recurring", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "between 0 and 1000 (0 means 1)")
+}
+
+func TestRecurringHandler_Create_EndBeforeStart(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	body := `{"type":"expense","amount":1500,"category":"rent","frequency":"monthly",` +
+		`"start_date":"2026-01-31","end_date":"2026-01-01"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/recurring", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRecurringHandler_Pause_NotFound(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	svc.EXPECT().Pause(mock.Anything, testItemID()).Return(domain.RecurringItem{}, domain.ErrRecurringNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/recurring/"+testItemID()+"/pause", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestRecurringHandler_Preview_Success(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	svc.EXPECT().Preview(mock.Anything, testItemID(), 2).Return([]time.Time{
+		time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/recurring/"+testItemID()+"/preview?count=2", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Occurrences []string `json:"occurrences"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"2026-01-31", "2026-02-28"}, resp.Occurrences)
+}
+
+func TestRecurringHandler_Preview_InvalidCount(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/recurring/"+testItemID()+"/preview?count=abc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRecurringHandler_Delete_InvalidID(t *testing.T) {
+	svc := newMockrecurringService(t)
+	h := NewRecurringHandler(svc, newTestLogger(t))
+	router := setupRecurringRouter(h)
+
+	svc.EXPECT().Delete(mock.Anything, "bad").Return(domain.ErrInvalidID)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/recurring/bad", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+type transferService interface {
+	Create(ctx context.Context, p domain.TransferParams) (domain.Transfer, error)
+	GetByID(ctx context.Context, id string) (domain.Transfer, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type TransferHandler struct {
+	svc transferService
+	log logger.Logger
+}
+
+func NewTransferHandler(svc transferService, log logger.Logger) *TransferHandler {
+	return &TransferHandler{
+		svc: svc,
+		log: log,
+	}
+}
+
+// Create - POST /api/transfers.
+func (h *TransferHandler) Create(c *ginext.Context) {
+	var req TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params, err := req.ToTransferParams()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	created, err := h.svc.Create(c.Request.Context(), params)
+	if err != nil {
+		h.respondTransferError(c, err, "create transfer")
+		return
+	}
+
+	respondJSON(c, http.StatusCreated, created)
+}
+
+// GetByID - GET /api/transfers/:id.
+func (h *TransferHandler) GetByID(c *ginext.Context) {
+	transfer, err := h.svc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondTransferError(c, err, "get transfer by id")
+		return
+	}
+
+	respondJSON(c, http.StatusOK, transfer)
+}
+
+// Delete - DELETE /api/transfers/:id.
+func (h *TransferHandler) Delete(c *ginext.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondTransferError(c, err, "delete transfer")
+		return
+	}
+
+	respondNoContent(c)
+}
+
+func (h *TransferHandler) respondTransferError(c *ginext.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrTransferNotFound):
+		respondError(c, http.StatusNotFound, "transfer not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		respondError(c, http.StatusBadRequest, "invalid transfer id")
+	case domain.IsValidationError(err):
+		respondError(c, http.StatusBadRequest, err.Error())
+	default:
+		h.log.LogAttrs(c.Request.Context(), logger.ErrorLevel, msg,
+			logger.String("error", err.Error()))
+		respondError(c, http.StatusInternalServerError, "internal server error")
+	}
+}
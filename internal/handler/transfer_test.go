@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTransferRouter(h *TransferHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/transfers", gin.HandlerFunc(h.Create))
+	r.GET("/api/transfers/:id", gin.HandlerFunc(h.GetByID))
+	r.DELETE("/api/transfers/:id", gin.HandlerFunc(h.Delete))
+	return r
+}
+
+func TestTransferHandler_Create_Success(t *testing.T) {
+	svc := newMocktransferService(t)
+	h := NewTransferHandler(svc, newTestLogger(t))
+	router := setupTransferRouter(h)
+
+	svc.EXPECT().Create(mock.Anything, mock.MatchedBy(func(p domain.TransferParams) bool {
+		return p.Amount.Equal(decimal.NewFromInt(500)) && p.ToAmount == nil
+	})).Return(domain.Transfer{ID: testItemID()}, nil)
+
+	body := `{"from_account_id":"` + testItemID() + `","to_account_id":"660e8400-e29b-41d4-a716-446655440001",` +
+		`"amount":500,"date":"2024-03-01"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/transfers", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestTransferHandler_Create_SameAccount(t *testing.T) {
+	svc := newMocktransferService(t)
+	h := NewTransferHandler(svc, newTestLogger(t))
+	router := setupTransferRouter(h)
+
+	body := `{"from_account_id":"` + testItemID() + `","to_account_id":"` + testItemID() + `",` +
+		`"amount":500,"date":"2024-03-01"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/transfers", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTransferHandler_GetByID_NotFound(t *testing.T) {
+	svc := newMocktransferService(t)
+	h := NewTransferHandler(svc, newTestLogger(t))
+	router := setupTransferRouter(h)
+
+	svc.EXPECT().GetByID(mock.Anything, testItemID()).Return(domain.Transfer{}, domain.ErrTransferNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/transfers/"+testItemID(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+// itemRequiredColumns — начало заголовка export.WriteCSV; остальные колонки необязательны.
+var itemRequiredColumns = []string{"id", "type", "amount", "category", "description", "date"}
+
+// ItemRecord — строка файла записей как есть, без проверки значений.
+// Err заполнен, если строку не удалось разобрать (например, не то число колонок).
+type ItemRecord struct {
+	Line        int
+	ID          string
+	Type        string
+	Amount      string
+	Currency    string
+	Category    string
+	Description string
+	Date        string
+	Err         error
+}
+
+// ParseItemsCSV читает файл в формате экспорта. Заголовок должен начинаться с колонок
+// id,type,amount,category,description,date; из остальных учитывается только currency.
+// Ошибка возвращается лишь для файла целиком (пустой файл, неверный заголовок, битые кавычки),
+// проблемы отдельных строк попадают в ItemRecord.Err.
+func ParseItemsCSV(r io.Reader) ([]ItemRecord, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: empty file", domain.ErrValidation)
+		}
+		return nil, fmt.Errorf("%w: %s", domain.ErrValidation, err.Error())
+	}
+	if len(header) < len(itemRequiredColumns) {
+		return nil, fmt.Errorf("%w: line 1: expected header starting with %s",
+			domain.ErrValidation, strings.Join(itemRequiredColumns, ","))
+	}
+	if err = checkHeader(header[:len(itemRequiredColumns)], itemRequiredColumns); err != nil {
+		return nil, err
+	}
+
+	currencyIdx := -1
+	for i := len(itemRequiredColumns); i < len(header); i++ {
+		if strings.ToLower(strings.TrimSpace(header[i])) == "currency" {
+			currencyIdx = i
+		}
+	}
+
+	var records []ItemRecord
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(err, csv.ErrFieldCount) {
+			records = append(records, ItemRecord{
+				Line: parseErr.StartLine,
+				Err:  fmt.Errorf("expected %d fields, got %d", len(header), len(record)),
+			})
+			continue
+		}
+		if err != nil {
+			// csv.ParseError уже содержит номер строки.
+			return nil, fmt.Errorf("%w: %s", domain.ErrValidation, err.Error())
+		}
+		line, _ := cr.FieldPos(0)
+
+		rec := ItemRecord{
+			Line:        line,
+			ID:          strings.TrimSpace(record[0]),
+			Type:        strings.TrimSpace(record[1]),
+			Amount:      strings.TrimSpace(record[2]),
+			Category:    strings.TrimSpace(record[3]),
+			Description: strings.TrimSpace(record[4]),
+			Date:        strings.TrimSpace(record[5]),
+		}
+		if currencyIdx >= 0 {
+			rec.Currency = strings.ToUpper(strings.TrimSpace(record[currencyIdx]))
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
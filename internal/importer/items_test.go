@@ -0,0 +1,90 @@
+package importer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stpnv0/SalesTracker/internal/export"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseItemsCSV_ExportRoundTrip(t *testing.T) {
+	items := []domain.Item{
+		{
+			ID:          "550e8400-e29b-41d4-a716-446655440000",
+			Type:        domain.TypeExpense,
+			Amount:      decimal.RequireFromString("12.50"),
+			Currency:    "USD",
+			Category:    "food",
+			Description: "lunch, with \"quotes\"",
+			Date:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, export.WriteCSV(&buf, items))
+
+	records, err := ParseItemsCSV(&buf)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	rec := records[0]
+	assert.NoError(t, rec.Err)
+	assert.Equal(t, 2, rec.Line)
+	assert.Equal(t, items[0].ID, rec.ID)
+	assert.Equal(t, "expense", rec.Type)
+	assert.Equal(t, "12.50", rec.Amount)
+	assert.Equal(t, "USD", rec.Currency)
+	assert.Equal(t, "food", rec.Category)
+	assert.Equal(t, "lunch, with \"quotes\"", rec.Description)
+	assert.Equal(t, "2024-03-01", rec.Date)
+}
+
+func TestParseItemsCSV_MinimalHeader(t *testing.T) {
+	input := "id,type,amount,category,description,date\n,income,100,salary,,2024-01-31\n"
+
+	records, err := ParseItemsCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Empty(t, records[0].Currency)
+	assert.Equal(t, "100", records[0].Amount)
+}
+
+func TestParseItemsCSV_FieldCountPerRow(t *testing.T) {
+	input := "id,type,amount,category,description,date\n" +
+		",income,100,salary,,2024-01-31\n" +
+		",income,100\n" +
+		",expense,5,food,,2024-02-01\n"
+
+	records, err := ParseItemsCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.NoError(t, records[0].Err)
+	assert.Error(t, records[1].Err)
+	assert.Equal(t, 3, records[1].Line)
+	assert.NoError(t, records[2].Err)
+	assert.Equal(t, 4, records[2].Line)
+}
+
+func TestParseItemsCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty file", input: ""},
+		{name: "wrong header", input: "id,kind,amount,category,description,date\n"},
+		{name: "short header", input: "id,type,amount\n"},
+		{name: "bare quote", input: "id,type,amount,category,description,date\n,income,1,a\"b,,2024-01-01\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseItemsCSV(strings.NewReader(tt.input))
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+var rateHeader = []string{"date", "from", "to", "rate"}
+
+// ParseRatesCSV читает курсы в формате date,from,to,rate. Файл принимается только целиком:
+// первая ошибка возвращается с номером строки и оборачивает domain.ErrValidation.
+func ParseRatesCSV(r io.Reader) ([]domain.ExchangeRate, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(rateHeader)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: empty file", domain.ErrValidation)
+		}
+		return nil, fmt.Errorf("%w: %s", domain.ErrValidation, err.Error())
+	}
+	if err = checkHeader(header, rateHeader); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var rates []domain.ExchangeRate
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// csv.ParseError уже содержит номер строки.
+			return nil, fmt.Errorf("%w: %s", domain.ErrValidation, err.Error())
+		}
+		line, _ := cr.FieldPos(0)
+
+		rate, err := parseRateRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", domain.ErrValidation, line, err.Error())
+		}
+		rate.CreatedAt = now
+		rate.UpdatedAt = now
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+func parseRateRecord(record []string) (domain.ExchangeRate, error) {
+	date, err := time.Parse("2006-01-02", strings.TrimSpace(record[0]))
+	if err != nil {
+		return domain.ExchangeRate{}, errors.New("invalid date format, expected YYYY-MM-DD")
+	}
+
+	from := strings.ToUpper(strings.TrimSpace(record[1]))
+	to := strings.ToUpper(strings.TrimSpace(record[2]))
+	if !domain.IsValidCurrency(from) || !domain.IsValidCurrency(to) {
+		return domain.ExchangeRate{}, domain.ErrInvalidCurrency
+	}
+	if from == to {
+		return domain.ExchangeRate{}, errors.New("from and to currencies must differ")
+	}
+
+	rate, err := decimal.NewFromString(strings.TrimSpace(record[3]))
+	if err != nil || !rate.IsPositive() {
+		return domain.ExchangeRate{}, errors.New("rate must be a positive decimal number")
+	}
+
+	return domain.ExchangeRate{Date: date, From: from, To: to, Rate: rate}, nil
+}
+
+func checkHeader(got, want []string) error {
+	if len(got) != len(want) {
+		return fmt.Errorf("%w: line 1: expected header %s", domain.ErrValidation, strings.Join(want, ","))
+	}
+	for i := range want {
+		if strings.ToLower(strings.TrimSpace(got[i])) != want[i] {
+			return fmt.Errorf("%w: line 1: expected header %s", domain.ErrValidation, strings.Join(want, ","))
+		}
+	}
+	return nil
+}
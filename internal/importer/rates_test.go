@@ -0,0 +1,56 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRatesCSV_Success(t *testing.T) {
+	input := "date,from,to,rate\n2026-02-01,USD,RUB,90.5\n2026-02-01,eur,RUB,98.1234\n"
+
+	rates, err := ParseRatesCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "USD", rates[0].From)
+	assert.Equal(t, "RUB", rates[0].To)
+	assert.True(t, decimal.RequireFromString("90.5").Equal(rates[0].Rate))
+	assert.Equal(t, 2026, rates[0].Date.Year())
+	assert.Equal(t, "EUR", rates[1].From)
+	assert.False(t, rates[1].CreatedAt.IsZero())
+}
+
+func TestParseRatesCSV_HeaderOnly(t *testing.T) {
+	rates, err := ParseRatesCSV(strings.NewReader("date,from,to,rate\n"))
+	require.NoError(t, err)
+	assert.Empty(t, rates)
+}
+
+func TestParseRatesCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		line  string
+	}{
+		{name: "empty file", input: ""},
+		{name: "wrong header", input: "day,from,to,rate\n", line: "line 1"},
+		{name: "bad date", input: "date,from,to,rate\n01.02.2026,USD,RUB,90\n", line: "line 2"},
+		{name: "bad currency", input: "date,from,to,rate\n2026-02-01,US,RUB,90\n", line: "line 2"},
+		{name: "same currency", input: "date,from,to,rate\n2026-02-01,RUB,RUB,1\n", line: "line 2"},
+		{name: "zero rate", input: "date,from,to,rate\n2026-02-01,USD,RUB,90\n2026-02-02,USD,RUB,0\n", line: "line 3"},
+		{name: "missing column", input: "date,from,to,rate\n2026-02-01,USD,RUB\n", line: "line 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRatesCSV(strings.NewReader(tt.input))
+			require.Error(t, err)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Contains(t, err.Error(), tt.line)
+		})
+	}
+}
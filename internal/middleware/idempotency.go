@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/ginext"
+	"github.com/wb-go/wbf/logger"
+)
+
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	idempotentReplayHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders — заголовки ответа, которые сохраняются и отдаются при повторе.
+var replayedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+type idempotencyStore interface {
+	Reserve(ctx context.Context, key, requestHash string, ttl time.Duration) (domain.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, rec domain.IdempotencyRecord) error
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency повторяет сохранённый ответ на запрос с тем же Idempotency-Key и телом.
+// Тот же ключ с другим телом — 422, ключ, чей запрос ещё выполняется, — 409.
+// Ответы 5xx не сохраняются: после них ключ освобождается и запрос можно повторить.
+func Idempotency(store idempotencyStore, ttl time.Duration, log logger.Logger) ginext.HandlerFunc {
+	return func(c *ginext.Context) {
+		key := c.GetHeader(idempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithError(c, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abortWithError(c, http.StatusBadRequest, "cannot read request body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		hash := requestHash(c.Request.Method, c.Request.URL.Path, body)
+
+		existing, reserved, err := store.Reserve(ctx, key, hash, ttl)
+		if err != nil {
+			log.LogAttrs(ctx, logger.ErrorLevel, "reserve idempotency key",
+				logger.String("error", err.Error()))
+			abortWithError(c, http.StatusInternalServerError, "internal server error")
+			return
+		}
+
+		if !reserved {
+			switch {
+			case existing.RequestHash != hash:
+				abortWithError(c, http.StatusUnprocessableEntity,
+					"Idempotency-Key has already been used with a different request")
+			case existing.InProgress():
+				abortWithError(c, http.StatusConflict,
+					"request with this Idempotency-Key is still being processed")
+			default:
+				for name, value := range existing.Headers {
+					c.Header(name, value)
+				}
+				c.Header(idempotentReplayHeader, "true")
+				c.Data(existing.StatusCode, existing.Headers["Content-Type"], existing.Response)
+				c.Abort()
+			}
+			return
+		}
+
+		writer := &capturingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		// Запрос мог быть отменён клиентом, а результат всё равно нужно сохранить.
+		storeCtx := context.WithoutCancel(ctx)
+		status := writer.Status()
+		if status >= http.StatusInternalServerError {
+			if err = store.Release(storeCtx, key); err != nil {
+				log.LogAttrs(ctx, logger.ErrorLevel, "release idempotency key",
+					logger.String("error", err.Error()))
+			}
+			return
+		}
+
+		headers := make(map[string]string, len(replayedHeaders))
+		for _, name := range replayedHeaders {
+			if v := writer.Header().Get(name); v != "" {
+				headers[name] = v
+			}
+		}
+		if err = store.Complete(storeCtx, domain.IdempotencyRecord{
+			Key:         key,
+			RequestHash: hash,
+			StatusCode:  status,
+			Headers:     headers,
+			Response:    writer.body.Bytes(),
+		}); err != nil {
+			log.LogAttrs(ctx, logger.ErrorLevel, "complete idempotency key",
+				logger.String("error", err.Error()))
+		}
+	}
+}
+
+func requestHash(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func abortWithError(c *ginext.Context, code int, msg string) {
+	c.AbortWithStatusJSON(code, ginext.H{"error": msg})
+}
+
+// capturingWriter дублирует тело ответа в буфер.
+type capturingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *capturingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
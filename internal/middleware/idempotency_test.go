@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/logger"
+)
+
+const testTTL = time.Hour
+
+func setupIdempotencyRouter(t *testing.T, store idempotencyStore, handler gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	log, err := logger.InitLogger(logger.SlogEngine, "test", "test")
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/items", gin.HandlerFunc(Idempotency(store, testTTL, log)), handler)
+	return r
+}
+
+func newIdempotentRequest(key, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/items", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+	return req
+}
+
+func TestIdempotency_NoKey(t *testing.T) {
+	store := newMockidempotencyStore(t)
+	router := setupIdempotencyRouter(t, store, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("", `{"amount":1}`))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestIdempotency_FirstRequestStored(t *testing.T) {
+	store := newMockidempotencyStore(t)
+	router := setupIdempotencyRouter(t, store, func(c *gin.Context) {
+		c.Header("ETag", `"1"`)
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	hash := requestHash(http.MethodPost, "/api/items", []byte(`{"amount":1}`))
+	store.EXPECT().Reserve(mock.Anything, "key-1", hash, testTTL).Return(domain.IdempotencyRecord{}, true, nil)
+	store.EXPECT().Complete(mock.Anything, mock.MatchedBy(func(rec domain.IdempotencyRecord) bool {
+		return rec.Key == "key-1" && rec.StatusCode == http.StatusCreated &&
+			string(rec.Response) == `{"id":"1"}` && rec.Headers["ETag"] == `"1"`
+	})).Return(nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Empty(t, w.Header().Get(idempotentReplayHeader))
+}
+
+func TestIdempotency_Replay(t *testing.T) {
+	store := newMockidempotencyStore(t)
+	router := setupIdempotencyRouter(t, store, func(c *gin.Context) {
+		t.Fatal("handler must not run on replay")
+	})
+
+	hash := requestHash(http.MethodPost, "/api/items", []byte(`{"amount":1}`))
+	store.EXPECT().Reserve(mock.Anything, "key-1", hash, testTTL).Return(domain.IdempotencyRecord{
+		Key:         "key-1",
+		RequestHash: hash,
+		StatusCode:  http.StatusCreated,
+		Headers:     map[string]string{"Content-Type": "application/json; charset=utf-8", "ETag": `"1"`},
+		Response:    []byte(`{"id":"1"}`),
+	}, false, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":1}`))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"id":"1"}`, w.Body.String())
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+	assert.Equal(t, "true", w.Header().Get(idempotentReplayHeader))
+}
+
+func TestIdempotency_DifferentBody(t *testing.T) {
+	store := newMockidempotencyStore(t)
+	router := setupIdempotencyRouter(t, store, func(c *gin.Context) {
+		t.Fatal("handler must not run")
+	})
+
+	store.EXPECT().Reserve(mock.Anything, "key-1", mock.Anything, testTTL).Return(domain.IdempotencyRecord{
+		Key:         "key-1",
+		RequestHash: requestHash(http.MethodPost, "/api/items", []byte(`{"amount":1}`)),
+		StatusCode:  http.StatusCreated,
+	}, false, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("key-1", `{"amount":2}`))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestIdempotency_InProgress(t *testing.T) {
+	store := newMockidempotencyStore(t)
+	router := setupIdempotencyRouter(t, store, func(c *gin.Context) {
+		t.Fatal("handler must not run")
+	})
+
+	hash := requestHash(http.MethodPost, "/api/items", []byte(`{}`))
+	store.EXPECT().Reserve(mock.Anything, "key-1", hash, testTTL).
+		Return(domain.IdempotencyRecord{Key: "key-1", RequestHash: hash}, false, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("key-1", `{}`))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestIdempotency_ServerErrorReleasesKey(t *testing.T) {
+	store := newMockidempotencyStore(t)
+	router := setupIdempotencyRouter(t, store, func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	})
+
+	store.EXPECT().Reserve(mock.Anything, "key-1", mock.Anything, testTTL).Return(domain.IdempotencyRecord{}, true, nil)
+	store.EXPECT().Release(mock.Anything, "key-1").Return(nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("key-1", `{}`))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+const accountColumns = "id, name, currency, opening_balance, closed, created_at, updated_at"
+
+// signedAmount — сумма записи со знаком: доходы увеличивают остаток счёта, расходы уменьшают.
+const signedAmount = "CASE WHEN type = 'income' THEN amount ELSE -amount END"
+
+type AccountRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewAccountRepo(db *dbpg.DB, strategy retry.Strategy) *AccountRepo {
+	return &AccountRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+func scanAccount(row rowScanner, acc *domain.Account) error {
+	return row.Scan(
+		&acc.ID, &acc.Name, &acc.Currency, &acc.OpeningBalance,
+		&acc.Closed, &acc.CreatedAt, &acc.UpdatedAt,
+	)
+}
+
+func (r *AccountRepo) Create(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	query := `
+		INSERT INTO accounts (name, currency, opening_balance, closed, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + accountColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		acc.Name, acc.Currency, acc.OpeningBalance, acc.Closed, acc.CreatedAt, acc.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Account{}, accountWriteError("create account", err)
+	}
+
+	var created domain.Account
+	if err = scanAccount(row, &created); err != nil {
+		return domain.Account{}, accountWriteError("scan created account", err)
+	}
+	return created, nil
+}
+
+func (r *AccountRepo) GetByID(ctx context.Context, id string) (domain.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return domain.Account{}, fmt.Errorf("get account by id: %w", err)
+	}
+
+	var acc domain.Account
+	if err = scanAccount(row, &acc); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Account{}, domain.ErrAccountNotFound
+		}
+		return domain.Account{}, fmt.Errorf("scan account: %w", err)
+	}
+	return acc, nil
+}
+
+func (r *AccountRepo) GetAll(ctx context.Context, includeClosed bool) ([]domain.Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM accounts
+		WHERE $1 OR NOT closed
+		ORDER BY name`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, includeClosed)
+	if err != nil {
+		return nil, fmt.Errorf("get accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []domain.Account
+	for rows.Next() {
+		var acc domain.Account
+		if err = scanAccount(rows, &acc); err != nil {
+			return nil, fmt.Errorf("scan account: %w", err)
+		}
+		accounts = append(accounts, acc)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return accounts, nil
+}
+
+func (r *AccountRepo) Update(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	query := `
+		UPDATE accounts
+		SET name = $2, currency = $3, opening_balance = $4, closed = $5, updated_at = $6
+		WHERE id = $1
+		RETURNING ` + accountColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		acc.ID, acc.Name, acc.Currency, acc.OpeningBalance, acc.Closed, acc.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Account{}, accountWriteError("update account", err)
+	}
+
+	var updated domain.Account
+	if err = scanAccount(row, &updated); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Account{}, domain.ErrAccountNotFound
+		}
+		return domain.Account{}, accountWriteError("scan updated account", err)
+	}
+	return updated, nil
+}
+
+// Delete удаляет счёт; счёт, на который ссылаются записи (в том числе из корзины), удалить нельзя.
+func (r *AccountRepo) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM accounts WHERE id = $1`
+
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return domain.ErrAccountInUse
+		}
+		return fmt.Errorf("delete account: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrAccountNotFound
+	}
+
+	return nil
+}
+
+// HasItems сообщает, есть ли у счёта записи, включая корзину.
+func (r *AccountRepo) HasItems(ctx context.Context, id string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM items WHERE account_id = $1)`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return false, fmt.Errorf("check account items: %w", err)
+	}
+
+	var has bool
+	if err = row.Scan(&has); err != nil {
+		return false, fmt.Errorf("scan account items: %w", err)
+	}
+	return has, nil
+}
+
+// Balance считает остаток счёта на конец дня at: начальный остаток плюс все живые записи по эту дату.
+func (r *AccountRepo) Balance(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error) {
+	query := `
+		SELECT a.currency, a.opening_balance + COALESCE(SUM(` + signedAmount + `), 0)
+		FROM accounts a
+		LEFT JOIN items i ON i.account_id = a.id AND i.deleted_at IS NULL AND i.date <= $2
+		WHERE a.id = $1
+		GROUP BY a.id`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id, at)
+	if err != nil {
+		return domain.AccountBalance{}, fmt.Errorf("get account balance: %w", err)
+	}
+
+	balance := domain.AccountBalance{AccountID: id, At: at}
+	if err = row.Scan(&balance.Currency, &balance.Balance); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.AccountBalance{}, domain.ErrAccountNotFound
+		}
+		return domain.AccountBalance{}, fmt.Errorf("scan account balance: %w", err)
+	}
+	return balance, nil
+}
+
+// Statement возвращает записи счёта по возрастанию даты с остатком после каждой из них.
+// Нарастающий итог считается по всем записям до filter.To, а уже потом отсекаются записи
+// раньше filter.From, поэтому остаток первой строки учитывает всю предыдущую историю.
+func (r *AccountRepo) Statement(
+	ctx context.Context, filter domain.StatementFilter,
+) ([]domain.StatementEntry, int64, error) {
+	innerClauses := []string{"account_id = $1", "deleted_at IS NULL"}
+	args := []interface{}{filter.AccountID}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		innerClauses = append(innerClauses, fmt.Sprintf("date <= $%d", len(args)))
+	}
+
+	outerWhere := ""
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		outerWhere = fmt.Sprintf("WHERE s.date >= $%d", len(args))
+	}
+
+	limit := defaultLimit
+	if filter.Limit > 0 && filter.Limit <= maxLimit {
+		limit = filter.Limit
+	}
+	offset := 0
+	if filter.Offset > 0 {
+		offset = filter.Offset
+	}
+
+	query := fmt.Sprintf(`
+		SELECT s.*, COUNT(*) OVER() AS total_count
+		FROM (
+			SELECT
+				%s,
+				(SELECT opening_balance FROM accounts WHERE id = $1)
+					+ SUM(%s) OVER (ORDER BY date, created_at, id ROWS UNBOUNDED PRECEDING) AS balance
+			FROM items
+			WHERE %s
+		) AS s
+		%s
+		ORDER BY s.date, s.created_at, s.id
+		LIMIT %d OFFSET %d`,
+		itemColumns, signedAmount, strings.Join(innerClauses, " AND "), outerWhere, limit, offset)
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get account statement: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		entries    []domain.StatementEntry
+		totalCount int64
+	)
+	for rows.Next() {
+		var e domain.StatementEntry
+		if err = scanItem(rows, &e.Item, &e.Balance, &totalCount); err != nil {
+			return nil, 0, fmt.Errorf("scan statement entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return entries, totalCount, nil
+}
+
+// checkItemAccountTx проверяет счёт записи: он существует, открыт (если не allowClosed)
+// и ведётся в валюте записи. Строка счёта блокируется до конца транзакции.
+func checkItemAccountTx(ctx context.Context, tx *sql.Tx, accountID, currency string, allowClosed bool) error {
+	var (
+		accountCurrency string
+		closed          bool
+	)
+	err := tx.QueryRowContext(ctx,
+		`SELECT currency, closed FROM accounts WHERE id = $1 FOR SHARE`, accountID,
+	).Scan(&accountCurrency, &closed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", domain.ErrUnknownAccount, accountID)
+		}
+		return fmt.Errorf("select item account: %w", err)
+	}
+
+	if closed && !allowClosed {
+		return domain.ErrAccountClosed
+	}
+	if accountCurrency != currency {
+		return fmt.Errorf("%w: account currency is %s", domain.ErrAccountCurrency, accountCurrency)
+	}
+	return nil
+}
+
+func accountWriteError(op string, err error) error {
+	if isUniqueViolation(err) {
+		return domain.ErrAccountExists
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	"github.com/wb-go/wbf/dbpg"
@@ -24,74 +23,281 @@ func NewAnalyticsRepo(db *dbpg.DB, strategy retry.Strategy) *AnalyticsRepo {
 	}
 }
 
-func buildAnalyticsWhere(from, to time.Time, itemType string) (string, []interface{}) {
-	clauses := []string{"date >= $1", "date <= $2"}
-	args := []interface{}{from, to}
+func buildAnalyticsWhere(filter domain.AnalyticsFilter) (string, []interface{}) {
+	clauses := []string{"date >= $1", "date <= $2", "deleted_at IS NULL"}
+	args := []interface{}{filter.From, filter.To}
 
-	if itemType != "" {
+	if filter.Type != "" {
 		clauses = append(clauses, fmt.Sprintf("type = $%d", len(args)+1))
-		args = append(args, itemType)
+		args = append(args, filter.Type)
 	}
+	// переводы между счетами не доход и не расход: без include_transfers они не учитываются
+	if !filter.IncludeTransfers {
+		clauses = append(clauses, "transfer_id IS NULL")
+	}
+	clauses, args = appendFieldClauses(clauses, args, filter.FieldFilter)
 
 	return "WHERE " + strings.Join(clauses, " AND "), args
 }
 
-var allowedGroupBy = map[string]struct {
+// rateLateral подбирает курс валюты записи i в базовую валюту, действующий на i.date.
+// Обратная пара (base→currency) тоже подходит — тогда курс инвертируется.
+const rateLateral = `
+	LEFT JOIN LATERAL (
+		SELECT CASE WHEN er.from_currency = i.currency THEN er.rate ELSE 1 / er.rate END AS rate
+		FROM exchange_rates er
+		WHERE er.date <= i.date
+		  AND ((er.from_currency = i.currency AND er.to_currency = %[1]s)
+		    OR (er.from_currency = %[1]s AND er.to_currency = i.currency))
+		ORDER BY er.date DESC, (er.from_currency = i.currency) DESC
+		LIMIT 1
+	) r ON i.currency <> %[1]s`
+
+// analyticsItems возвращает таблицу записей для аналитики под псевдонимом alias. С filter.Timezone
+// датой записи (date) считается день её создания (created_at) в этом часовом поясе: по нему
+// отбирается период, строятся интервалы и подбирается курс.
+func analyticsItems(filter domain.AnalyticsFilter, args []interface{}, alias string) (string, []interface{}) {
+	if filter.Timezone == "" {
+		return "items " + alias, args
+	}
+	args = append(args, filter.Timezone)
+	return fmt.Sprintf(`(
+		SELECT id, type, category, currency, amount, description, deleted_at, transfer_id,
+		       (created_at AT TIME ZONE $%d)::date AS date
+		FROM items
+	) AS %s`, len(args), alias), args
+}
+
+// buildAnalyticsSource возвращает FROM-часть запроса аналитики вместе с фильтрами.
+// При заданной базовой валюте суммы пересчитываются в неё, а записи без курса отбрасываются
+// (их отдельно возвращает FindUnconverted).
+func buildAnalyticsSource(filter domain.AnalyticsFilter) (string, []interface{}) {
+	where, args := buildAnalyticsWhere(filter)
+	if filter.BaseCurrency == "" {
+		items, args := analyticsItems(filter, args, "items")
+		return items + " " + where, args
+	}
+
+	items, args := analyticsItems(filter, args, "i")
+	args = append(args, filter.BaseCurrency)
+	base := fmt.Sprintf("$%d", len(args))
+
+	source := fmt.Sprintf(`(
+		SELECT
+			i.id, i.type, i.category, i.date,
+			%[1]s::char(3) AS currency,
+			CASE WHEN i.currency = %[1]s THEN i.amount ELSE i.amount * r.rate END AS amount
+		FROM %[3]s`+rateLateral+`
+		%[2]s
+	) AS items
+	WHERE amount IS NOT NULL`, base, where, items)
+
+	return source, args
+}
+
+type groupExprs struct {
 	selectExpr string
 	groupExpr  string
 	orderExpr  string
-}{
-	"day":      {"date::text", "date", "date"},
-	"week":     {"DATE_TRUNC('week', date)::date::text", "DATE_TRUNC('week', date)", "DATE_TRUNC('week', date)"},
-	"month":    {"DATE_TRUNC('month', date)::date::text", "DATE_TRUNC('month', date)", "DATE_TRUNC('month', date)"},
-	"category": {"category", "category", "category"},
 }
 
-func (r *AnalyticsRepo) Aggregate(ctx context.Context, from, to time.Time, itemType string) (domain.AnalyticsResult, error) {
-	where, args := buildAnalyticsWhere(from, to, itemType)
+// analyticsGroupBy возвращает выражения группировки для filter.GroupBy. Интервалы времени
+// обозначаются датой своего начала.
+func analyticsGroupBy(filter domain.AnalyticsFilter) (groupExprs, bool) {
+	if bucket, ok := timeBucket(filter, "date"); ok {
+		return groupExprs{bucket + "::text", bucket, bucket}, true
+	}
+	switch filter.GroupBy {
+	case domain.GroupByCategory:
+		return groupExprs{"category", "category", "category"}, true
+	case domain.GroupByTag:
+		return groupExprs{"tag", "tag", "tag"}, true
+	}
+	return groupExprs{}, false
+}
+
+// timeBucket возвращает дату начала интервала filter.GroupBy, в который попадает дата col;
+// неделя начинается с filter.WeekStartDay().
+func timeBucket(filter domain.AnalyticsFilter, col string) (string, bool) {
+	switch filter.GroupBy {
+	case domain.GroupByDay:
+		return col, true
+	case domain.GroupByWeek:
+		// ISODOW: понедельник — 1, воскресенье — 7
+		isoStart := int(filter.WeekStartDay())
+		if isoStart == 0 {
+			isoStart = 7
+		}
+		return fmt.Sprintf("(%[1]s - (EXTRACT(ISODOW FROM %[1]s)::int - %[2]d + 7) %% 7)", col, isoStart), true
+	case domain.GroupByMonth, domain.GroupByQuarter, domain.GroupByYear:
+		return fmt.Sprintf("DATE_TRUNC('%s', %s)::date", filter.GroupBy, col), true
+	}
+	return "", false
+}
+
+var bucketStep = map[string]string{
+	domain.GroupByDay:     "1 day",
+	domain.GroupByWeek:    "1 week",
+	domain.GroupByMonth:   "1 month",
+	domain.GroupByQuarter: "3 months",
+	domain.GroupByYear:    "1 year",
+}
+
+// bucketGrid возвращает FROM-часть для fill=zero: по строке на каждый интервал периода s.bucket
+// и каждое сочетание значений keys из подзапроса combos (c.*) вместе с агрегатами CTE agg (a.*) —
+// NULL у пустых интервалов. Границы периода — параметры $1 и $2 из buildAnalyticsWhere.
+func bucketGrid(filter domain.AnalyticsFilter, combos string, keys ...string) string {
+	start, _ := timeBucket(filter, "$1::date")
+	join := make([]string, 0, len(keys)+1)
+	join = append(join, "a.bucket = s.bucket")
+	for _, k := range keys {
+		join = append(join, fmt.Sprintf("a.%[1]s = c.%[1]s", k))
+	}
+	return fmt.Sprintf(`(
+			SELECT ts::date AS bucket
+			FROM generate_series(%s::timestamp, $2::timestamp, interval '%s') AS ts
+		) AS s
+		CROSS JOIN (%s) AS c
+		LEFT JOIN agg a ON %s`,
+		start, bucketStep[filter.GroupBy], combos, strings.Join(join, " AND "))
+}
+
+// gridCurrencies — валюты ряда fill=zero: встретившиеся в agg, а если за период записей нет —
+// валюта fallback, чтобы пустой период дал нулевые интервалы, а не пустой ответ.
+func gridCurrencies(fallback string) string {
+	return fmt.Sprintf(`
+			SELECT DISTINCT currency FROM agg
+			UNION ALL
+			SELECT %s::char(3) WHERE NOT EXISTS (SELECT 1 FROM agg)`, fallback)
+}
+
+// fillCurrency — валюта нулевого ряда пустого периода: базовая, если задана, иначе валюта по умолчанию.
+func fillCurrency(filter domain.AnalyticsFilter) string {
+	if filter.BaseCurrency != "" {
+		return filter.BaseCurrency
+	}
+	return domain.DefaultCurrency
+}
+
+// tagSource раскладывает записи source по их тегам: запись с несколькими тегами даёт
+// по строке на каждый тег, записи без тегов в группировку не попадают.
+func tagSource(source string) string {
+	return fmt.Sprintf(`(
+		SELECT t.name AS tag, src.currency, src.amount
+		FROM (SELECT id, currency, amount FROM %s) AS src
+		JOIN item_tags it ON it.item_id = src.id
+		JOIN tags t ON t.id = it.tag_id
+	) AS tagged`, source)
+}
+
+// splitSource заменяет записи source с разбивкой их строками: каждая строка идёт в свою категорию.
+// Сумма строки берётся как доля суммы записи, поэтому пересчёт в базовую валюту на неё переносится.
+func splitSource(source string) string {
+	return fmt.Sprintf(`(
+		SELECT
+			COALESCE(s.category, i.category) AS category,
+			i.currency,
+			i.date,
+			i.type,
+			CASE WHEN s.item_id IS NULL THEN i.amount ELSE i.amount * s.amount / p.amount END AS amount
+		FROM (SELECT id, category, currency, date, type, amount FROM %s) AS i
+		LEFT JOIN item_splits s ON s.item_id = i.id
+		LEFT JOIN items p ON p.id = s.item_id
+	) AS src`, source)
+}
+
+// Aggregate считает статистику отдельно по каждой валюте.
+func (r *AnalyticsRepo) Aggregate(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.AnalyticsResult, error) {
+	source, args := buildAnalyticsSource(filter)
 
 	query := fmt.Sprintf(`
 		SELECT
+			currency,
 			COUNT(*)                                                         AS count,
 			COALESCE(SUM(amount), 0)                                         AS total_sum,
 			COALESCE(AVG(amount), 0)                                         AS avg,
 			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount), 0) AS median,
 			COALESCE(PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY amount), 0) AS p90
-		FROM items %s`, where)
+		FROM %s
+		GROUP BY currency
+		ORDER BY currency`, source)
 
-	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, args...)
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
 	if err != nil {
-		return domain.AnalyticsResult{}, fmt.Errorf("aggregate analytics: %w", err)
+		return nil, fmt.Errorf("aggregate analytics: %w", err)
 	}
+	defer rows.Close()
 
-	var res domain.AnalyticsResult
-	if err = row.Scan(&res.Count, &res.TotalSum, &res.Avg, &res.Median, &res.P90); err != nil {
-		return domain.AnalyticsResult{}, fmt.Errorf("scan analytics: %w", err)
+	var res []domain.AnalyticsResult
+	for rows.Next() {
+		var a domain.AnalyticsResult
+		if err = rows.Scan(&a.Currency, &a.Count, &a.TotalSum, &a.Avg, &a.Median, &a.P90); err != nil {
+			return nil, fmt.Errorf("scan analytics: %w", err)
+		}
+		res = append(res, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
 	}
 
 	return res, nil
 }
 
-func (r *AnalyticsRepo) AggregateGrouped(ctx context.Context, from, to time.Time, groupBy, itemType string) ([]domain.GroupedAnalytics, error) {
-	gb, ok := allowedGroupBy[groupBy]
+// AggregateGrouped считает статистику по группам, каждая группа разбита по валютам.
+func (r *AnalyticsRepo) AggregateGrouped(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.GroupedAnalytics, error) {
+	gb, ok := analyticsGroupBy(filter)
 	if !ok {
-		return nil, fmt.Errorf("unsupported group_by value: %q", groupBy)
+		return nil, fmt.Errorf("unsupported group_by value: %q", filter.GroupBy)
 	}
 
-	where, args := buildAnalyticsWhere(from, to, itemType)
+	source, args := buildAnalyticsSource(filter)
+	switch filter.GroupBy {
+	case domain.GroupByTag:
+		source = tagSource(source)
+	case domain.GroupByCategory:
+		source = splitSource(source)
+	}
 
 	query := fmt.Sprintf(`
 		SELECT
 			%s                                                               AS key,
+			currency,
 			COUNT(*)                                                         AS count,
 			COALESCE(SUM(amount), 0)                                         AS total_sum,
 			COALESCE(AVG(amount), 0)                                         AS avg,
 			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount), 0) AS median,
 			COALESCE(PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY amount), 0) AS p90
-		FROM items %s
-		GROUP BY %s
-		ORDER BY %s`,
-		gb.selectExpr, where, gb.groupExpr, gb.orderExpr)
+		FROM %s
+		GROUP BY %s, currency
+		ORDER BY %s, currency`,
+		gb.selectExpr, source, gb.groupExpr, gb.orderExpr)
+	if filter.Fill == domain.FillZero {
+		args = append(args, fillCurrency(filter))
+		query = fmt.Sprintf(`
+			WITH agg AS (
+				SELECT
+					%[1]s                                                   AS bucket,
+					currency,
+					COUNT(*)                                                AS count,
+					SUM(amount)                                             AS total_sum,
+					AVG(amount)                                             AS avg,
+					PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount)     AS median,
+					PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY amount)     AS p90
+				FROM %[2]s
+				GROUP BY %[1]s, currency
+			)
+			SELECT
+				s.bucket::text,
+				c.currency,
+				COALESCE(a.count, 0),
+				COALESCE(a.total_sum, 0),
+				COALESCE(a.avg, 0),
+				COALESCE(a.median, 0),
+				COALESCE(a.p90, 0)
+			FROM %[3]s
+			ORDER BY s.bucket, c.currency`,
+			gb.groupExpr, source, bucketGrid(filter, gridCurrencies(fmt.Sprintf("$%d", len(args))), "currency"))
+	}
 
 	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
 	if err != nil {
@@ -102,7 +308,7 @@ func (r *AnalyticsRepo) AggregateGrouped(ctx context.Context, from, to time.Time
 	var res []domain.GroupedAnalytics
 	for rows.Next() {
 		var g domain.GroupedAnalytics
-		if err = rows.Scan(&g.Key, &g.Count, &g.TotalSum, &g.Avg, &g.Median, &g.P90); err != nil {
+		if err = rows.Scan(&g.Key, &g.Currency, &g.Count, &g.TotalSum, &g.Avg, &g.Median, &g.P90); err != nil {
 			return nil, fmt.Errorf("scan grouped analytics: %w", err)
 		}
 		res = append(res, g)
@@ -113,3 +319,232 @@ func (r *AnalyticsRepo) AggregateGrouped(ctx context.Context, from, to time.Time
 
 	return res, nil
 }
+
+// AggregatePivot считает статистику по сочетаниям интервала filter.GroupBy и значения
+// filter.PivotBy (категории — с учётом разбивки записей) отдельно по каждой валюте.
+// Возвращаются только непустые ячейки, а при fill=zero — все интервалы периода.
+func (r *AnalyticsRepo) AggregatePivot(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.PivotCell, error) {
+	bucket, ok := timeBucket(filter, "date")
+	if !ok {
+		return nil, fmt.Errorf("unsupported group_by value: %q", filter.GroupBy)
+	}
+
+	source, args := buildAnalyticsSource(filter)
+	var column string
+	switch filter.PivotBy {
+	case domain.GroupByCategory:
+		source, column = splitSource(source), "category"
+	case domain.GroupByType:
+		column = "type"
+	default:
+		return nil, fmt.Errorf("unsupported pivot value: %q", filter.PivotBy)
+	}
+
+	row, col, cur, from := "a.bucket", "a.col", "a.currency", "agg a"
+	if filter.Fill == domain.FillZero {
+		row, col, cur, from = "s.bucket", "c.col", "c.currency", bucketGrid(filter, "SELECT DISTINCT col, currency FROM agg", "col", "currency")
+	}
+	query := fmt.Sprintf(`
+		WITH agg AS (
+			SELECT
+				%[1]s                                               AS bucket,
+				%[2]s                                               AS col,
+				currency,
+				COUNT(*)                                            AS count,
+				SUM(amount)                                         AS total_sum,
+				AVG(amount)                                         AS avg,
+				PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount) AS median,
+				PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY amount) AS p90
+			FROM %[3]s
+			GROUP BY %[1]s, %[2]s, currency
+		)
+		SELECT
+			%[4]s::text,
+			%[5]s,
+			%[6]s,
+			COALESCE(a.count, 0),
+			COALESCE(a.total_sum, 0),
+			COALESCE(a.avg, 0),
+			COALESCE(a.median, 0),
+			COALESCE(a.p90, 0)
+		FROM %[7]s
+		ORDER BY %[4]s, %[5]s, %[6]s`,
+		bucket, column, source, row, col, cur, from)
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate pivot analytics: %w", err)
+	}
+	defer rows.Close()
+
+	var res []domain.PivotCell
+	for rows.Next() {
+		var c domain.PivotCell
+		if err = rows.Scan(
+			&c.RowKey, &c.ColumnKey, &c.Currency,
+			&c.Count, &c.TotalSum, &c.Avg, &c.Median, &c.P90,
+		); err != nil {
+			return nil, fmt.Errorf("scan pivot analytics: %w", err)
+		}
+		res = append(res, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return res, nil
+}
+
+// AggregateCategoryTree считает статистику по каждой категории вместе со всеми её потомками:
+// запись (или строка её разбивки) учитывается в своей категории и во всех её предках, поэтому
+// родитель считается по исходным суммам, а не по итогам дочерних групп.
+func (r *AnalyticsRepo) AggregateCategoryTree(
+	ctx context.Context, filter domain.AnalyticsFilter,
+) ([]domain.CategoryTreeGroup, error) {
+	source, args := buildAnalyticsSource(filter)
+
+	// UNION (а не UNION ALL) останавливает рекурсию даже на испорченной иерархии с циклом.
+	query := fmt.Sprintf(`
+		WITH RECURSIVE ancestors (category_id, ancestor_id) AS (
+			SELECT id, id FROM categories
+			UNION
+			SELECT a.category_id, c.parent_id
+			FROM ancestors a
+			JOIN categories c ON c.id = a.ancestor_id
+			WHERE c.parent_id IS NOT NULL
+		)
+		SELECT
+			anc.id,
+			anc.parent_id,
+			anc.name                                                             AS key,
+			src.currency,
+			COUNT(*)                                                             AS count,
+			COALESCE(SUM(src.amount), 0)                                         AS total_sum,
+			COALESCE(AVG(src.amount), 0)                                         AS avg,
+			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY src.amount), 0) AS median,
+			COALESCE(PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY src.amount), 0) AS p90
+		FROM %s
+		JOIN categories cat ON cat.name = src.category
+		JOIN ancestors a ON a.category_id = cat.id
+		JOIN categories anc ON anc.id = a.ancestor_id
+		GROUP BY anc.id, anc.parent_id, anc.name, src.currency
+		ORDER BY anc.name, src.currency`, splitSource(source))
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate category tree analytics: %w", err)
+	}
+	defer rows.Close()
+
+	var res []domain.CategoryTreeGroup
+	for rows.Next() {
+		var g domain.CategoryTreeGroup
+		if err = rows.Scan(
+			&g.CategoryID, &g.ParentID, &g.Key, &g.Currency,
+			&g.Count, &g.TotalSum, &g.Avg, &g.Median, &g.P90,
+		); err != nil {
+			return nil, fmt.Errorf("scan category tree analytics: %w", err)
+		}
+		res = append(res, g)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return res, nil
+}
+
+// Cashflow считает за один проход доходы, расходы и их разницу по интервалам filter.GroupBy,
+// а также нарастающий итог разницы отдельно по каждой валюте.
+func (r *AnalyticsRepo) Cashflow(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CashflowBucket, error) {
+	gb, ok := analyticsGroupBy(filter)
+	if !ok {
+		return nil, fmt.Errorf("unsupported group_by value: %q", filter.GroupBy)
+	}
+
+	source, args := buildAnalyticsSource(filter)
+
+	// нарастающий итог считается уже по интервалам, поэтому при fill=zero
+	// пустой интервал повторяет итог предыдущего
+	bucket, currency, from := "a.bucket", "a.currency", "agg a"
+	if filter.Fill == domain.FillZero {
+		args = append(args, fillCurrency(filter))
+		from = bucketGrid(filter, gridCurrencies(fmt.Sprintf("$%d", len(args))), "currency")
+		bucket, currency = "s.bucket", "c.currency"
+	}
+	query := fmt.Sprintf(`
+		WITH agg AS (
+			SELECT
+				%[1]s                                      AS bucket,
+				currency,
+				SUM(amount) FILTER (WHERE type = 'income')  AS income,
+				SUM(amount) FILTER (WHERE type = 'expense') AS expense,
+				SUM(%[2]s)                                 AS net
+			FROM %[3]s
+			GROUP BY %[1]s, currency
+		)
+		SELECT
+			%[4]s::text,
+			%[5]s,
+			COALESCE(a.income, 0),
+			COALESCE(a.expense, 0),
+			COALESCE(a.net, 0),
+			SUM(COALESCE(a.net, 0)) OVER (PARTITION BY %[5]s ORDER BY %[4]s)
+		FROM %[6]s
+		ORDER BY %[5]s, %[4]s`,
+		gb.groupExpr, signedAmount, source, bucket, currency, from)
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate cashflow: %w", err)
+	}
+	defer rows.Close()
+
+	var res []domain.CashflowBucket
+	for rows.Next() {
+		var b domain.CashflowBucket
+		if err = rows.Scan(&b.Key, &b.Currency, &b.Income, &b.Expense, &b.Net, &b.CumulativeNet); err != nil {
+			return nil, fmt.Errorf("scan cashflow: %w", err)
+		}
+		res = append(res, b)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return res, nil
+}
+
+// FindUnconverted возвращает записи периода, которые нельзя пересчитать в filter.BaseCurrency.
+func (r *AnalyticsRepo) FindUnconverted(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.UnconvertedItem, error) {
+	where, args := buildAnalyticsWhere(filter)
+	items, args := analyticsItems(filter, args, "i")
+	args = append(args, filter.BaseCurrency)
+	base := fmt.Sprintf("$%d", len(args))
+
+	query := fmt.Sprintf(`
+		SELECT i.id, i.currency, i.amount, i.date
+		FROM %[3]s`+rateLateral+`
+		%[2]s AND i.currency <> %[1]s AND r.rate IS NULL
+		ORDER BY i.date, i.id`, base, where, items)
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("find unconverted items: %w", err)
+	}
+	defer rows.Close()
+
+	var res []domain.UnconvertedItem
+	for rows.Next() {
+		var u domain.UnconvertedItem
+		if err = rows.Scan(&u.ItemID, &u.Currency, &u.Amount, &u.Date); err != nil {
+			return nil, fmt.Errorf("scan unconverted item: %w", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsRepo_FillZero_EmptyPeriod(t *testing.T) {
+	repo := NewAnalyticsRepo(newTestDB(t), testStrategy)
+	ctx := context.Background()
+
+	// записей за этот период заведомо нет
+	filter := domain.AnalyticsFilter{
+		From:    time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(1900, 3, 31, 0, 0, 0, 0, time.UTC),
+		GroupBy: domain.GroupByMonth,
+		Fill:    domain.FillZero,
+	}
+
+	groups, err := repo.AggregateGrouped(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+	assert.Equal(t, "1900-01-01", groups[0].Key)
+	assert.Equal(t, domain.DefaultCurrency, groups[0].Currency)
+	assert.Equal(t, int64(0), groups[2].Count)
+
+	filter.BaseCurrency = "USD"
+	buckets, err := repo.Cashflow(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, buckets, 3)
+	assert.Equal(t, "USD", buckets[0].Currency)
+	assert.True(t, buckets[2].CumulativeNet.IsZero())
+}
+
+func TestAnalyticsRepo_Timezone_BucketsByCreatedAt(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewAnalyticsRepo(db, testStrategy)
+	ctx := context.Background()
+
+	// 23:30 UTC 31 января — уже 1 февраля в Токио; других записей, созданных в 1901 году, нет
+	createdAt := time.Date(1901, 1, 31, 23, 30, 0, 0, time.UTC)
+	_, err := NewItemRepo(db, testStrategy).Create(ctx, domain.Item{
+		Type:      domain.TypeExpense,
+		Amount:    decimal.NewFromInt(100),
+		Currency:  "RUB",
+		Category:  "Еда",
+		Date:      time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	})
+	require.NoError(t, err)
+
+	filter := domain.AnalyticsFilter{
+		From:     time.Date(1901, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(1901, 12, 31, 0, 0, 0, 0, time.UTC),
+		GroupBy:  domain.GroupByMonth,
+		Timezone: "Asia/Tokyo",
+	}
+	groups, err := repo.AggregateGrouped(ctx, filter)
+	require.NoError(t, err)
+	require.NotEmpty(t, groups)
+	assert.Equal(t, "1901-02-01", groups[len(groups)-1].Key)
+
+	filter.Timezone = "America/New_York"
+	groups, err = repo.AggregateGrouped(ctx, filter)
+	require.NoError(t, err)
+	require.NotEmpty(t, groups)
+	assert.Equal(t, "1901-01-01", groups[0].Key)
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+const attachmentColumns = "id, item_id, file_name, mime_type, size, storage_key, created_at"
+
+type AttachmentRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewAttachmentRepo(db *dbpg.DB, strategy retry.Strategy) *AttachmentRepo {
+	return &AttachmentRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+func scanAttachment(row rowScanner, a *domain.Attachment) error {
+	return row.Scan(&a.ID, &a.ItemID, &a.FileName, &a.MimeType, &a.Size, &a.StorageKey, &a.CreatedAt)
+}
+
+// Create сохраняет метаданные вложения; запись должна существовать и не лежать в корзине.
+func (r *AttachmentRepo) Create(ctx context.Context, a domain.Attachment) (domain.Attachment, error) {
+	query := `
+		INSERT INTO attachments (id, item_id, file_name, mime_type, size, storage_key, created_at)
+		SELECT $1, id, $3, $4, $5, $6, $7
+		FROM items
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING ` + attachmentColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		a.ID, a.ItemID, a.FileName, a.MimeType, a.Size, a.StorageKey, a.CreatedAt,
+	)
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("create attachment: %w", err)
+	}
+
+	var created domain.Attachment
+	if err = scanAttachment(row, &created); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Attachment{}, domain.ErrItemNotFound
+		}
+		return domain.Attachment{}, fmt.Errorf("scan created attachment: %w", err)
+	}
+	return created, nil
+}
+
+// List возвращает вложения записи в порядке загрузки.
+func (r *AttachmentRepo) List(ctx context.Context, itemID string) ([]domain.Attachment, error) {
+	existsQuery := `SELECT EXISTS (SELECT 1 FROM items WHERE id = $1 AND deleted_at IS NULL)`
+	listQuery := `
+		SELECT ` + attachmentColumns + `
+		FROM attachments
+		WHERE item_id = $1
+		ORDER BY created_at, id`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, existsQuery, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("check item: %w", err)
+	}
+	var exists bool
+	if err = row.Scan(&exists); err != nil {
+		return nil, fmt.Errorf("scan item exists: %w", err)
+	}
+	if !exists {
+		return nil, domain.ErrItemNotFound
+	}
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, listQuery, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("get attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []domain.Attachment
+	for rows.Next() {
+		var a domain.Attachment
+		if err = scanAttachment(rows, &a); err != nil {
+			return nil, fmt.Errorf("scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return attachments, nil
+}
+
+func (r *AttachmentRepo) GetByID(ctx context.Context, itemID, id string) (domain.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM attachments a
+		WHERE a.id = $1 AND a.item_id = $2
+		  AND EXISTS (SELECT 1 FROM items i WHERE i.id = a.item_id AND i.deleted_at IS NULL)`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id, itemID)
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("get attachment by id: %w", err)
+	}
+
+	var a domain.Attachment
+	if err = scanAttachment(row, &a); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Attachment{}, domain.ErrAttachmentNotFound
+		}
+		return domain.Attachment{}, fmt.Errorf("scan attachment: %w", err)
+	}
+	return a, nil
+}
+
+// Delete удаляет метаданные вложения и возвращает их, чтобы можно было удалить файл.
+func (r *AttachmentRepo) Delete(ctx context.Context, itemID, id string) (domain.Attachment, error) {
+	query := `
+		DELETE FROM attachments a
+		USING items i
+		WHERE a.id = $1 AND a.item_id = $2 AND i.id = a.item_id AND i.deleted_at IS NULL
+		RETURNING a.id, a.item_id, a.file_name, a.mime_type, a.size, a.storage_key, a.created_at`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id, itemID)
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("delete attachment: %w", err)
+	}
+
+	var deleted domain.Attachment
+	if err = scanAttachment(row, &deleted); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Attachment{}, domain.ErrAttachmentNotFound
+		}
+		return domain.Attachment{}, fmt.Errorf("scan deleted attachment: %w", err)
+	}
+	return deleted, nil
+}
+
+// purgeAttachmentsTx удаляет вложения записей, попавших в корзину раньше olderThan,
+// и возвращает ключи их файлов.
+func purgeAttachmentsTx(ctx context.Context, tx *sql.Tx, olderThan time.Time) ([]string, error) {
+	query := `
+		DELETE FROM attachments
+		WHERE item_id IN (SELECT id FROM items WHERE deleted_at IS NOT NULL AND deleted_at < $1)
+		RETURNING storage_key`
+
+	rows, err := tx.QueryContext(ctx, query, olderThan)
+	if err != nil {
+		return nil, fmt.Errorf("purge attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("scan attachment key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return keys, nil
+}
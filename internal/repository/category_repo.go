@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+const categoryColumns = "id, name, parent_id, COALESCE(type, ''), archived, created_at, updated_at"
+
+const pgForeignKeyViolation = "23503"
+
+type CategoryRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewCategoryRepo(db *dbpg.DB, strategy retry.Strategy) *CategoryRepo {
+	return &CategoryRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+func scanCategory(row rowScanner, cat *domain.Category) error {
+	return row.Scan(
+		&cat.ID, &cat.Name, &cat.ParentID, &cat.Type,
+		&cat.Archived, &cat.CreatedAt, &cat.UpdatedAt,
+	)
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgForeignKeyViolation
+}
+
+// Create добавляет категорию; имя, отличающееся от существующего только регистром, отклоняется.
+func (r *CategoryRepo) Create(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	query := `
+		INSERT INTO categories (name, parent_id, type, archived, created_at, updated_at)
+		SELECT $1, $2, NULLIF($3, ''), $4, $5, $6
+		WHERE NOT EXISTS (SELECT 1 FROM categories WHERE lower(name) = lower($1))
+		RETURNING ` + categoryColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		cat.Name, cat.ParentID, cat.Type, cat.Archived, cat.CreatedAt, cat.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Category{}, categoryWriteError("create category", err)
+	}
+
+	var created domain.Category
+	if err = scanCategory(row, &created); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrCategoryExists
+		}
+		return domain.Category{}, categoryWriteError("scan created category", err)
+	}
+	return created, nil
+}
+
+func (r *CategoryRepo) GetByID(ctx context.Context, id string) (domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("get category by id: %w", err)
+	}
+
+	var cat domain.Category
+	if err = scanCategory(row, &cat); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrCategoryNotFound
+		}
+		return domain.Category{}, fmt.Errorf("scan category: %w", err)
+	}
+	return cat, nil
+}
+
+// GetByName ищет категорию без учёта регистра; точное совпадение имени в приоритете.
+func (r *CategoryRepo) GetByName(ctx context.Context, name string) (domain.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE lower(name) = lower($1)
+		ORDER BY name = $1 DESC
+		LIMIT 1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, name)
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("get category by name: %w", err)
+	}
+
+	var cat domain.Category
+	if err = scanCategory(row, &cat); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrCategoryNotFound
+		}
+		return domain.Category{}, fmt.Errorf("scan category: %w", err)
+	}
+	return cat, nil
+}
+
+func (r *CategoryRepo) GetAll(ctx context.Context, includeArchived bool) ([]domain.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE $1 OR NOT archived
+		ORDER BY name`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("get categories: %w", err)
+	}
+	defer rows.Close()
+
+	var cats []domain.Category
+	for rows.Next() {
+		var cat domain.Category
+		if err = scanCategory(rows, &cat); err != nil {
+			return nil, fmt.Errorf("scan category: %w", err)
+		}
+		cats = append(cats, cat)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return cats, nil
+}
+
+func (r *CategoryRepo) Update(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	query := `
+		UPDATE categories
+		SET name = $2, parent_id = $3, type = NULLIF($4, ''), archived = $5, updated_at = $6
+		WHERE id = $1
+		  AND NOT EXISTS (SELECT 1 FROM categories WHERE lower(name) = lower($2) AND id <> $1)
+		RETURNING ` + categoryColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		cat.ID, cat.Name, cat.ParentID, cat.Type, cat.Archived, cat.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Category{}, categoryWriteError("update category", err)
+	}
+
+	var updated domain.Category
+	if err = scanCategory(row, &updated); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, categoryWriteError("scan updated category", err)
+		}
+		// Ни одной строки: либо категории нет, либо имя занято другой.
+		if _, err = r.GetByID(ctx, cat.ID); err != nil {
+			return domain.Category{}, err
+		}
+		return domain.Category{}, domain.ErrCategoryExists
+	}
+	return updated, nil
+}
+
+// Delete удаляет категорию; категорию с подкатегориями удалить нельзя.
+func (r *CategoryRepo) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM categories WHERE id = $1`
+
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return domain.ErrCategoryInUse
+		}
+		return fmt.Errorf("delete category: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// InUse сообщает, есть ли записи (включая корзину), строки их разбивки или шаблоны с категорией name.
+// Непустой otherThanType учитывает только записи другого типа.
+func (r *CategoryRepo) InUse(ctx context.Context, name, otherThanType string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM items WHERE category = $1 AND ($2 = '' OR type <> $2))
+		    OR EXISTS (SELECT 1 FROM item_splits s JOIN items i ON i.id = s.item_id
+		               WHERE s.category = $1 AND ($2 = '' OR i.type <> $2))
+		    OR EXISTS (SELECT 1 FROM recurring_items WHERE category = $1 AND ($2 = '' OR type <> $2))`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, name, otherThanType)
+	if err != nil {
+		return false, fmt.Errorf("check category usage: %w", err)
+	}
+
+	var used bool
+	if err = row.Scan(&used); err != nil {
+		return false, fmt.Errorf("scan category usage: %w", err)
+	}
+	return used, nil
+}
+
+func categoryWriteError(op string, err error) error {
+	switch {
+	case isUniqueViolation(err):
+		return domain.ErrCategoryExists
+	case isForeignKeyViolation(err):
+		return domain.ErrParentCategory
+	default:
+		return fmt.Errorf("%s: %w", op, err)
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRepo_InUse_SplitsOnly(t *testing.T) {
+	db := newTestDB(t)
+	items := NewItemRepo(db, testStrategy)
+	categories := NewCategoryRepo(db, testStrategy)
+	ctx := context.Background()
+	now := time.Now()
+
+	// категория встречается только в строке разбивки, но не у самой записи
+	name := "split-" + uuid.NewString()[:8]
+	_, err := items.Create(ctx, domain.Item{
+		Type:      domain.TypeExpense,
+		Amount:    decimal.NewFromInt(100),
+		Currency:  "RUB",
+		Category:  "Еда",
+		Splits:    []domain.ItemSplit{{Category: name, Amount: decimal.NewFromInt(100)}},
+		Date:      now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	require.NoError(t, err)
+
+	used, err := categories.InUse(ctx, name, "")
+	require.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = categories.InUse(ctx, name, domain.TypeIncome)
+	require.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = categories.InUse(ctx, name, domain.TypeExpense)
+	require.NoError(t, err)
+	assert.False(t, used)
+}
+
+func TestCategoryRepo_NameUniqueIgnoringCase(t *testing.T) {
+	db := newTestDB(t)
+	categories := NewCategoryRepo(db, testStrategy)
+	ctx := context.Background()
+	now := time.Now()
+
+	name := "Case-" + uuid.NewString()[:8]
+	_, err := categories.Create(ctx, domain.Category{Name: name, CreatedAt: now, UpdatedAt: now})
+	require.NoError(t, err)
+
+	// в обход проверки NOT EXISTS, как при гонке двух запросов: дубликат отсекает индекс
+	_, err = db.Master.ExecContext(ctx, `INSERT INTO categories (name) VALUES ($1)`, strings.ToLower(name))
+	assert.True(t, isUniqueViolation(err))
+}
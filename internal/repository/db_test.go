@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+var testStrategy = retry.Strategy{Attempts: 1}
+
+// newTestDB подключается к отдельной тестовой базе из TEST_DATABASE_DSN и применяет миграции.
+// Без переменной тест пропускается: моки репозитория не ловят ошибок драйвера и SQL.
+func newTestDB(t *testing.T) *dbpg.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	migrationsDB, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	defer migrationsDB.Close()
+	require.NoError(t, goose.Up(migrationsDB, "../../migrations"))
+
+	db, err := dbpg.New(dsn, nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Master.Close() })
+
+	return db
+}
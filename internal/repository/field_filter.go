@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// appendFieldClauses дописывает к clauses условия filter, а их значения — к args;
+// номера параметров продолжают args.
+func appendFieldClauses(
+	clauses []string, args []interface{}, filter domain.FieldFilter,
+) ([]string, []interface{}) {
+	if filter.MinAmount != nil {
+		args = append(args, *filter.MinAmount)
+		clauses = append(clauses, fmt.Sprintf("amount >= $%d", len(args)))
+	}
+	if filter.MaxAmount != nil {
+		args = append(args, *filter.MaxAmount)
+		clauses = append(clauses, fmt.Sprintf("amount <= $%d", len(args)))
+	}
+	if len(filter.Categories) > 0 {
+		args = append(args, pq.Array(filter.Categories))
+		clauses = append(clauses, fmt.Sprintf("category = ANY($%d)", len(args)))
+	}
+	if len(filter.ExcludeCategories) > 0 {
+		args = append(args, pq.Array(filter.ExcludeCategories))
+		clauses = append(clauses, fmt.Sprintf("category <> ALL($%d)", len(args)))
+	}
+	if filter.DescriptionPrefix != "" {
+		args = append(args, likeEscaper.Replace(filter.DescriptionPrefix)+"%")
+		clauses = append(clauses, fmt.Sprintf("description ILIKE $%d", len(args)))
+	}
+	return clauses, args
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type IdempotencyRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewIdempotencyRepo(db *dbpg.DB, strategy retry.Strategy) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+// Reserve занимает ключ на ttl. Если ключ свободен или его срок истёк, возвращает reserved = true;
+// иначе — существующую запись, по которой вызывающий решает, повторить ответ или отказать.
+func (r *IdempotencyRepo) Reserve(
+	ctx context.Context, key, requestHash string, ttl time.Duration,
+) (domain.IdempotencyRecord, bool, error) {
+	reserveQuery := `
+		INSERT INTO idempotency_keys (key, request_hash, expires_at)
+		VALUES ($1, $2, now() + make_interval(secs => $3))
+		ON CONFLICT (key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+		    status_code  = NULL,
+		    headers      = NULL,
+		    response     = NULL,
+		    created_at   = now(),
+		    expires_at   = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()
+		RETURNING key`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, reserveQuery, key, requestHash, ttl.Seconds())
+	if err != nil {
+		return domain.IdempotencyRecord{}, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	var reserved string
+	err = row.Scan(&reserved)
+	if err == nil {
+		return domain.IdempotencyRecord{}, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return domain.IdempotencyRecord{}, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+
+	rec, err := r.get(ctx, key)
+	if err != nil {
+		return domain.IdempotencyRecord{}, false, err
+	}
+	return rec, false, nil
+}
+
+func (r *IdempotencyRepo) get(ctx context.Context, key string) (domain.IdempotencyRecord, error) {
+	query := `
+		SELECT key, request_hash, COALESCE(status_code, 0), headers, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE key = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, key)
+	if err != nil {
+		return domain.IdempotencyRecord{}, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	var (
+		rec     domain.IdempotencyRecord
+		headers []byte
+	)
+	if err = row.Scan(
+		&rec.Key, &rec.RequestHash, &rec.StatusCode, &headers, &rec.Response, &rec.CreatedAt, &rec.ExpiresAt,
+	); err != nil {
+		return domain.IdempotencyRecord{}, fmt.Errorf("scan idempotency key: %w", err)
+	}
+	if headers != nil {
+		if err = json.Unmarshal(headers, &rec.Headers); err != nil {
+			return domain.IdempotencyRecord{}, fmt.Errorf("unmarshal idempotency headers: %w", err)
+		}
+	}
+
+	return rec, nil
+}
+
+// Complete сохраняет ответ для занятого ключа.
+func (r *IdempotencyRepo) Complete(ctx context.Context, rec domain.IdempotencyRecord) error {
+	headers, err := json.Marshal(rec.Headers)
+	if err != nil {
+		return fmt.Errorf("marshal idempotency headers: %w", err)
+	}
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $2, headers = $3, response = $4
+		WHERE key = $1`
+
+	if _, err = r.db.ExecWithRetry(ctx, r.strategy, query, rec.Key, rec.StatusCode, headers, rec.Response); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release освобождает ключ, если исходный запрос завершился ошибкой сервера и его можно повторить.
+func (r *IdempotencyRepo) Release(ctx context.Context, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE key = $1 AND status_code IS NULL`
+
+	if _, err := r.db.ExecWithRetry(ctx, r.strategy, query, key); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired удаляет ключи с истёкшим сроком и возвращает их число.
+func (r *IdempotencyRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= now()`
+
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyRepo_DeleteExpired(t *testing.T) {
+	repo := NewIdempotencyRepo(newTestDB(t), testStrategy)
+	ctx := context.Background()
+
+	expired, live := uuid.NewString(), uuid.NewString()
+	_, reserved, err := repo.Reserve(ctx, expired, "hash", -time.Second)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	_, reserved, err = repo.Reserve(ctx, live, "hash", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	deleted, err := repo.DeleteExpired(ctx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = repo.get(ctx, expired)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = repo.get(ctx, live)
+	assert.NoError(t, err)
+}
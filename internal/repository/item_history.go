@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/logger"
+)
+
+// insertHistory пишет запись аудита в транзакции изменения. Request ID берётся из контекста
+// (его кладёт middleware.RequestID).
+func insertHistory(ctx context.Context, tx *sql.Tx, itemID, action string, oldValue, newValue *domain.Item) error {
+	oldJSON, err := marshalSnapshot(oldValue)
+	if err != nil {
+		return err
+	}
+	newJSON, err := marshalSnapshot(newValue)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO item_history (item_id, action, old_value, new_value, request_id)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	if _, err = tx.ExecContext(ctx, query,
+		itemID, action, oldJSON, newJSON, logger.GetRequestID(ctx),
+	); err != nil {
+		return fmt.Errorf("insert item history: %w", err)
+	}
+	return nil
+}
+
+// marshalSnapshot возвращает снимок записи для колонки JSONB. Отсутствующий снимок — нетипизированный nil:
+// пустой []byte драйвер передаёт пустой строкой, а не NULL, и JSONB её не принимает.
+func marshalSnapshot(item *domain.Item) (interface{}, error) {
+	if item == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("marshal item snapshot: %w", err)
+	}
+	return string(b), nil
+}
+
+func unmarshalSnapshot(b []byte) (*domain.Item, error) {
+	if b == nil {
+		return nil, nil
+	}
+	var item domain.Item
+	if err := json.Unmarshal(b, &item); err != nil {
+		return nil, fmt.Errorf("unmarshal item snapshot: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *ItemRepo) GetHistory(ctx context.Context, itemID string) ([]domain.ItemHistoryEntry, error) {
+	query := `
+		SELECT id, item_id, action, old_value, new_value, request_id, changed_at
+		FROM item_history
+		WHERE item_id = $1
+		ORDER BY id`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("get item history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.ItemHistoryEntry
+	for rows.Next() {
+		var (
+			e                domain.ItemHistoryEntry
+			oldJSON, newJSON []byte
+		)
+		if err = rows.Scan(
+			&e.ID, &e.ItemID, &e.Action, &oldJSON, &newJSON, &e.RequestID, &e.ChangedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan item history: %w", err)
+		}
+		if e.OldValue, err = unmarshalSnapshot(oldJSON); err != nil {
+			return nil, err
+		}
+		if e.NewValue, err = unmarshalSnapshot(newJSON); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Exists сообщает, есть ли запись в таблице, в том числе в корзине.
+func (r *ItemRepo) Exists(ctx context.Context, id string) (bool, error) {
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, `SELECT EXISTS (SELECT 1 FROM items WHERE id = $1)`, id)
+	if err != nil {
+		return false, fmt.Errorf("check item exists: %w", err)
+	}
+	var exists bool
+	if err = row.Scan(&exists); err != nil {
+		return false, fmt.Errorf("scan item exists: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalSnapshot_Nil(t *testing.T) {
+	v, err := marshalSnapshot(nil)
+	require.NoError(t, err)
+	// только нетипизированный nil драйвер передаёт как NULL
+	assert.True(t, v == nil)
+}
+
+func TestItemRepo_History_CreateDeletePurge(t *testing.T) {
+	repo := NewItemRepo(newTestDB(t), testStrategy)
+	ctx := context.Background()
+	now := time.Now()
+
+	created, err := repo.Create(ctx, domain.Item{
+		Type:      domain.TypeExpense,
+		Amount:    decimal.NewFromInt(100),
+		Currency:  "RUB",
+		Category:  "Еда",
+		Date:      now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Delete(ctx, created.ID))
+	_, _, err = repo.Purge(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	entries, err := repo.GetHistory(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, domain.HistoryActionCreate, entries[0].Action)
+	assert.Nil(t, entries[0].OldValue)
+	require.NotNil(t, entries[0].NewValue)
+	assert.Equal(t, created.ID, entries[0].NewValue.ID)
+
+	assert.Equal(t, domain.HistoryActionDelete, entries[1].Action)
+
+	assert.Equal(t, domain.HistoryActionPurge, entries[2].Action)
+	assert.NotNil(t, entries[2].OldValue)
+	assert.Nil(t, entries[2].NewValue)
+}
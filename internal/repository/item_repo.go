@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	"github.com/wb-go/wbf/dbpg"
 	"github.com/wb-go/wbf/retry"
@@ -29,40 +31,92 @@ func NewItemRepo(db *dbpg.DB, strategy retry.Strategy) *ItemRepo {
 	}
 }
 
+const itemColumns = "id, type, amount, currency, category, description, date, created_at, updated_at, deleted_at, version, account_id, transfer_id, " +
+	tagsColumn + ", " + splitsColumn
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanItem читает колонки в порядке itemColumns, extra — дополнительные колонки после них.
+func scanItem(row rowScanner, item *domain.Item, extra ...interface{}) error {
+	dest := []interface{}{
+		&item.ID, &item.Type, &item.Amount, &item.Currency, &item.Category,
+		&item.Description, &item.Date, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt,
+		&item.Version, &item.AccountID, &item.TransferID,
+		(*pq.StringArray)(&item.Tags), (*splitList)(&item.Splits),
+	}
+	return row.Scan(append(dest, extra...)...)
+}
+
 func (r *ItemRepo) Create(ctx context.Context, item domain.Item) (domain.Item, error) {
+	var created domain.Item
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) (err error) {
+		created, err = createItemTx(ctx, tx, item)
+		return err
+	})
+	if err != nil {
+		return domain.Item{}, err
+	}
+
+	return created, nil
+}
+
+func createItemTx(ctx context.Context, tx *sql.Tx, item domain.Item) (domain.Item, error) {
+	if item.AccountID != nil {
+		if err := checkItemAccountTx(ctx, tx, *item.AccountID, item.Currency, false); err != nil {
+			return domain.Item{}, err
+		}
+	}
+
+	// id задаётся только для записей по расписанию; обычно его генерирует база.
 	query := `
-		INSERT INTO items (type, amount, category, description, date, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, type, amount, category, description, date, created_at, updated_at`
-
-	row, err := r.db.QueryRowWithRetry(
-		ctx, r.strategy, query,
-		item.Type, item.Amount, item.Category, item.Description,
-		item.Date, item.CreatedAt, item.UpdatedAt,
+		INSERT INTO items (
+			id, type, amount, currency, category, description, date, created_at, updated_at, account_id, transfer_id
+		)
+		VALUES (COALESCE($1::uuid, gen_random_uuid()), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING ` + itemColumns
+
+	var id interface{}
+	if item.ID != "" {
+		id = item.ID
+	}
+	row := tx.QueryRowContext(ctx, query,
+		id, item.Type, item.Amount, item.Currency, item.Category, item.Description,
+		item.Date, item.CreatedAt, item.UpdatedAt, item.AccountID, item.TransferID,
 	)
-	if err != nil {
+	var created domain.Item
+	if err := scanItem(row, &created); err != nil {
+		if isUniqueViolation(err) {
+			return domain.Item{}, domain.ErrItemExists
+		}
 		return domain.Item{}, fmt.Errorf("create item: %w", err)
 	}
 
-	var created domain.Item
-	if err = row.Scan(
-		&created.ID, &created.Type, &created.Amount, &created.Category,
-		&created.Description, &created.Date, &created.CreatedAt, &created.UpdatedAt,
-	); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return domain.Item{}, domain.ErrItemNotFound
+	if len(item.Tags) > 0 {
+		if err := setItemTagsTx(ctx, tx, created.ID, item.Tags); err != nil {
+			return domain.Item{}, err
+		}
+		created.Tags = item.Tags
+	}
+	if len(item.Splits) > 0 {
+		if err := setItemSplitsTx(ctx, tx, created.ID, item.Splits); err != nil {
+			return domain.Item{}, err
 		}
-		return domain.Item{}, fmt.Errorf("scan created item: %w", err)
+		created.Splits = item.Splits
 	}
 
+	if err := insertHistory(ctx, tx, created.ID, domain.HistoryActionCreate, nil, &created); err != nil {
+		return domain.Item{}, err
+	}
 	return created, nil
 }
 
 func (r *ItemRepo) GetByID(ctx context.Context, id string) (domain.Item, error) {
 	query := `
-		SELECT id, type, amount, category, description, date, created_at, updated_at
+		SELECT ` + itemColumns + `
 		FROM items
-		WHERE id = $1`
+		WHERE id = $1 AND deleted_at IS NULL`
 
 	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
 	if err != nil {
@@ -70,10 +124,7 @@ func (r *ItemRepo) GetByID(ctx context.Context, id string) (domain.Item, error)
 	}
 
 	var item domain.Item
-	if err = row.Scan(
-		&item.ID, &item.Type, &item.Amount, &item.Category,
-		&item.Description, &item.Date, &item.CreatedAt, &item.UpdatedAt,
-	); err != nil {
+	if err = scanItem(row, &item); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Item{}, domain.ErrItemNotFound
 		}
@@ -83,56 +134,97 @@ func (r *ItemRepo) GetByID(ctx context.Context, id string) (domain.Item, error)
 	return item, nil
 }
 
-var allowedSortColumns = map[string]string{
-	domain.SortByDate:     "date",
-	domain.SortByAmount:   "amount",
-	domain.SortByCategory: "category",
-	domain.SortByType:     "type",
+// sortColumn — выражение сортировки, его текстовый вид для курсора и тип, к которому
+// ключ из курсора приводится обратно.
+type sortColumn struct {
+	expr    string
+	keyText string
+	keyType string
 }
 
-func (r *ItemRepo) GetAll(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error) {
-	whereClauses := make([]string, 0, 4)
+var allowedSortColumns = map[string]sortColumn{
+	domain.SortByDate:     {"date", "to_char(date, 'YYYY-MM-DD')", "date"},
+	domain.SortByAmount:   {"amount", "amount::text", "numeric"},
+	domain.SortByCategory: {"category", "category::text", "text"},
+	domain.SortByType:     {"type", "type::text", "text"},
+}
+
+// GetAll возвращает страницу записей. Сортировка всегда дополняется id, поэтому порядок
+// однозначен и при filter.Cursor следующая страница начинается строго после записи из курсора.
+func (r *ItemRepo) GetAll(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error) {
+	whereClauses := make([]string, 0, 5)
 	args := make([]interface{}, 0, 4)
-	argIdx := 1
 
+	if filter.Deleted {
+		whereClauses = append(whereClauses, "deleted_at IS NOT NULL")
+	} else {
+		whereClauses = append(whereClauses, "deleted_at IS NULL")
+	}
+
+	// номер параметра каждого условия — len(args) после добавления его значения
 	if filter.Type != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("type = $%d", argIdx))
 		args = append(args, filter.Type)
-		argIdx++
+		whereClauses = append(whereClauses, fmt.Sprintf("type = $%d", len(args)))
+	}
+	if filter.AccountID != "" {
+		args = append(args, filter.AccountID)
+		whereClauses = append(whereClauses, fmt.Sprintf("account_id = $%d", len(args)))
 	}
-	if filter.Category != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("category = $%d", argIdx))
-		args = append(args, filter.Category)
-		argIdx++
+	if len(filter.Tags) > 0 {
+		args = append(args, pq.Array(filter.Tags))
+		whereClauses = append(whereClauses, tagFilterClause(filter.TagMatch, len(args), len(filter.Tags)))
 	}
 	if filter.From != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("date >= $%d", argIdx))
 		args = append(args, *filter.From)
-		argIdx++
+		whereClauses = append(whereClauses, fmt.Sprintf("date >= $%d", len(args)))
 	}
 	if filter.To != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("date <= $%d", argIdx))
 		args = append(args, *filter.To)
-		argIdx++
+		whereClauses = append(whereClauses, fmt.Sprintf("date <= $%d", len(args)))
+	}
+	var tsQuery string
+	if filter.Query != "" {
+		args = append(args, filter.Query)
+		tsQuery = fmt.Sprintf("websearch_to_tsquery('russian', $%d)", len(args))
+		whereClauses = append(whereClauses, "description_tsv @@ "+tsQuery)
 	}
+	whereClauses, args = appendFieldClauses(whereClauses, args, filter.FieldFilter)
 
-	where := ""
-	if len(whereClauses) > 0 {
-		where = "WHERE " + strings.Join(whereClauses, " AND ")
+	sortBy, order := filter.Sort()
+	col, ok := allowedSortColumns[sortBy]
+	if !ok {
+		col = allowedSortColumns[domain.SortByDate]
+	}
+	if sortBy == domain.SortByRelevance && tsQuery != "" {
+		rank := "ts_rank(description_tsv, " + tsQuery + ")"
+		col = sortColumn{expr: rank, keyText: rank + "::text", keyType: "real"}
+	}
+	sortDir, cmp := "DESC", "<"
+	if order == domain.OrderAsc {
+		sortDir, cmp = "ASC", ">"
 	}
 
-	sortCol := "date"
-	if col, ok := allowedSortColumns[filter.SortBy]; ok {
-		sortCol = col
+	var page domain.ItemPage
+	if !filter.SkipTotal {
+		total, err := r.count(ctx, whereClauses, args)
+		if err != nil {
+			return domain.ItemPage{}, err
+		}
+		page.TotalCount = &total
 	}
-	sortDir := "DESC"
-	if filter.Order == domain.OrderAsc {
-		sortDir = "ASC"
+
+	// фильтр по курсору не влияет на общее количество, поэтому добавляется после подсчёта
+	if filter.Cursor != nil {
+		args = append(args, filter.Cursor.Key, filter.Cursor.ID)
+		whereClauses = append(whereClauses, fmt.Sprintf("(%s, id) %s ($%d::%s, $%d::uuid)",
+			col.expr, cmp, len(args)-1, col.keyType, len(args)))
 	}
 
+	// на одну запись больше страницы — чтобы узнать, есть ли следующая
+	limit := 0
 	var limitClause string
 	if !filter.NoLimit {
-		limit := defaultLimit
+		limit = defaultLimit
 		if filter.Limit > 0 && filter.Limit <= maxLimit {
 			limit = filter.Limit
 		}
@@ -140,90 +232,409 @@ func (r *ItemRepo) GetAll(ctx context.Context, filter domain.ItemFilter) ([]doma
 		if filter.Offset > 0 {
 			offset = filter.Offset
 		}
-		limitClause = fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+		limitClause = fmt.Sprintf("LIMIT %d OFFSET %d", limit+1, offset)
 	}
 
 	query := fmt.Sprintf(
 		`SELECT 
-					id, type, amount, category, description, date, created_at, updated_at,
-					COUNT(*) OVER() AS total_count
-			    FROM items %s
-			    ORDER BY %s %s
+					%s,
+					%s AS sort_key
+			    FROM items
+			    WHERE %s
+			    ORDER BY %s %s, id %s
 			    %s`,
-		where, sortCol, sortDir, limitClause,
+		itemColumns, col.keyText, strings.Join(whereClauses, " AND "),
+		col.expr, sortDir, sortDir, limitClause,
 	)
 
 	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("get all items: %w", err)
+		return domain.ItemPage{}, fmt.Errorf("get all items: %w", err)
 	}
 	defer rows.Close()
 
-	var (
-		items      []domain.Item
-		totalCount int64
-	)
+	var keys []string
 	for rows.Next() {
-		var i domain.Item
-		if err = rows.Scan(
-			&i.ID, &i.Type, &i.Amount, &i.Category, &i.Description,
-			&i.Date, &i.CreatedAt, &i.UpdatedAt, &totalCount,
-		); err != nil {
-			return nil, 0, fmt.Errorf("scan item: %w", err)
+		var (
+			i   domain.Item
+			key string
+		)
+		if err = scanItem(rows, &i, &key); err != nil {
+			return domain.ItemPage{}, fmt.Errorf("scan item: %w", err)
 		}
-		items = append(items, i)
+		page.Items = append(page.Items, i)
+		keys = append(keys, key)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, 0, fmt.Errorf("rows iteration: %w", err)
+		return domain.ItemPage{}, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	if limit > 0 && len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		page.NextCursor = domain.ItemCursor{
+			SortBy: sortBy, Order: order, Key: keys[limit-1], ID: last.ID,
+		}.Encode()
 	}
 
-	return items, totalCount, nil
+	return page, nil
 }
 
-func (r *ItemRepo) Update(ctx context.Context, item domain.Item) (domain.Item, error) {
-	query := `
-		UPDATE items
-		SET type = $2, amount = $3, category = $4, description = $5, date = $6, updated_at = $7
-		WHERE id = $1
-		RETURNING id, type, amount, category, description, date, created_at, updated_at`
+func (r *ItemRepo) count(ctx context.Context, whereClauses []string, args []interface{}) (int64, error) {
+	query := `SELECT COUNT(*) FROM items WHERE ` + strings.Join(whereClauses, " AND ")
 
-	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
-		item.ID, item.Type, item.Amount, item.Category,
-		item.Description, item.Date, item.UpdatedAt,
-	)
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, args...)
 	if err != nil {
-		return domain.Item{}, fmt.Errorf("update item: %w", err)
+		return 0, fmt.Errorf("count items: %w", err)
 	}
 
+	var total int64
+	if err = row.Scan(&total); err != nil {
+		return 0, fmt.Errorf("scan items count: %w", err)
+	}
+	return total, nil
+}
+
+// Update перезаписывает запись целиком.
+func (r *ItemRepo) Update(ctx context.Context, item domain.Item) (domain.Item, error) {
 	var updated domain.Item
-	if err = row.Scan(
-		&updated.ID, &updated.Type, &updated.Amount, &updated.Category,
-		&updated.Description, &updated.Date, &updated.CreatedAt, &updated.UpdatedAt,
-	); err != nil {
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) (err error) {
+		updated, err = updateItemTx(ctx, tx, item)
+		return err
+	})
+	if err != nil {
+		return domain.Item{}, err
+	}
+
+	return updated, nil
+}
+
+// updateItemTx заменяет запись целиком; пустая Currency оставляет валюту записи прежней.
+func updateItemTx(ctx context.Context, tx *sql.Tx, item domain.Item) (domain.Item, error) {
+	columns := []string{"type", "amount", "category", "description", "date", "account_id", "updated_at"}
+	values := []interface{}{
+		item.Type, item.Amount, item.Category, item.Description, item.Date, item.AccountID, item.UpdatedAt,
+	}
+	if item.Currency != "" {
+		columns = append(columns, "currency")
+		values = append(values, item.Currency)
+	}
+	return updateColumnsTx(ctx, tx, item.ID, item.Version, columns, values, &item.Tags, &item.Splits)
+}
+
+// Patch обновляет только заданные в патче колонки.
+func (r *ItemRepo) Patch(ctx context.Context, patch domain.ItemPatch) (domain.Item, error) {
+	columns := make([]string, 0, 8)
+	values := make([]interface{}, 0, 8)
+	add := func(column string, value interface{}) {
+		columns = append(columns, column)
+		values = append(values, value)
+	}
+
+	if patch.Type != nil {
+		add("type", *patch.Type)
+	}
+	if patch.Amount != nil {
+		add("amount", *patch.Amount)
+	}
+	if patch.Currency != nil {
+		add("currency", *patch.Currency)
+	}
+	if patch.Category != nil {
+		add("category", *patch.Category)
+	}
+	if patch.Description != nil {
+		add("description", *patch.Description)
+	}
+	if patch.Date != nil {
+		add("date", *patch.Date)
+	}
+	if patch.AccountID != nil {
+		var accountID interface{}
+		if *patch.AccountID != "" {
+			accountID = *patch.AccountID
+		}
+		add("account_id", accountID)
+	}
+	add("updated_at", patch.UpdatedAt)
+
+	var patched domain.Item
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) (err error) {
+		patched, err = updateColumnsTx(ctx, tx, patch.ID, patch.Version, columns, values, patch.Tags, patch.Splits)
+		return err
+	})
+	if err != nil {
+		return domain.Item{}, err
+	}
+
+	return patched, nil
+}
+
+// updateColumnsTx выставляет columns = values; прежнее состояние читается под FOR UPDATE и попадает в историю.
+// Если version задан, запись обновляется только при совпадении версии, иначе — ErrConflict.
+// tags и splits, если не nil, заменяют набор тегов и разбивку записи.
+func updateColumnsTx(
+	ctx context.Context, tx *sql.Tx, id string, version int64, columns []string, values []interface{},
+	tags *[]string, splits *[]domain.ItemSplit,
+) (domain.Item, error) {
+	selectQuery := `SELECT ` + itemColumns + ` FROM items WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	sets := make([]string, 0, len(columns)+1)
+	for i, col := range columns {
+		sets = append(sets, fmt.Sprintf("%s = $%d", col, i+2))
+	}
+	sets = append(sets, "version = version + 1")
+	updateQuery := fmt.Sprintf(`
+		UPDATE items
+		SET %s
+		WHERE id = $1 AND version = $%d
+		RETURNING %s`, strings.Join(sets, ", "), len(columns)+2, itemColumns)
+
+	var old domain.Item
+	if err := scanItem(tx.QueryRowContext(ctx, selectQuery, id), &old); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Item{}, domain.ErrItemNotFound
 		}
-		return domain.Item{}, fmt.Errorf("scan updated item: %w", err)
+		return domain.Item{}, fmt.Errorf("select item for update: %w", err)
+	}
+	if old.TransferID != nil {
+		return domain.Item{}, domain.ErrTransferLeg
+	}
+
+	expected := old.Version
+	if version != 0 {
+		expected = version
+	}
+
+	args := make([]interface{}, 0, len(values)+2)
+	args = append(args, id)
+	args = append(args, values...)
+	args = append(args, expected)
+
+	var updated domain.Item
+	if err := scanItem(tx.QueryRowContext(ctx, updateQuery, args...), &updated); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Item{}, domain.ErrConflict
+		}
+		return domain.Item{}, fmt.Errorf("update item: %w", err)
+	}
+
+	// Счёт проверяется, только если запись на него переносят или меняют ей валюту.
+	if updated.AccountID != nil {
+		sameAccount := old.AccountID != nil && *old.AccountID == *updated.AccountID
+		if !sameAccount || old.Currency != updated.Currency {
+			if err := checkItemAccountTx(ctx, tx, *updated.AccountID, updated.Currency, sameAccount); err != nil {
+				return domain.Item{}, err
+			}
+		}
+	}
+
+	if tags != nil {
+		if err := setItemTagsTx(ctx, tx, id, *tags); err != nil {
+			return domain.Item{}, err
+		}
+		updated.Tags = append([]string{}, *tags...)
+	}
+	if splits != nil {
+		if err := setItemSplitsTx(ctx, tx, id, *splits); err != nil {
+			return domain.Item{}, err
+		}
+		updated.Splits = append([]domain.ItemSplit{}, *splits...)
 	}
 
+	if err := insertHistory(ctx, tx, id, domain.HistoryActionUpdate, &old, &updated); err != nil {
+		return domain.Item{}, err
+	}
 	return updated, nil
 }
 
+// Delete переносит запись в корзину: строка остаётся в таблице с заполненным deleted_at.
 func (r *ItemRepo) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM items WHERE id = $1`
+	return withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) error {
+		return deleteItemTx(ctx, tx, id)
+	})
+}
+
+// deleteItemTx переносит запись в корзину; половина перевода уходит туда вместе со второй половиной.
+func deleteItemTx(ctx context.Context, tx *sql.Tx, id string) error {
+	deleted, err := softDeleteItemTx(ctx, tx, id)
+	if err != nil || deleted.TransferID == nil {
+		return err
+	}
+
+	legs, err := transferLegIDsTx(ctx, tx, *deleted.TransferID, id, false)
+	if err != nil {
+		return err
+	}
+	for _, legID := range legs {
+		if _, err = softDeleteItemTx(ctx, tx, legID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func softDeleteItemTx(ctx context.Context, tx *sql.Tx, id string) (domain.Item, error) {
+	query := `
+		UPDATE items
+		SET deleted_at = now(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING ` + itemColumns
+
+	var deleted domain.Item
+	if err := scanItem(tx.QueryRowContext(ctx, query, id), &deleted); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Item{}, domain.ErrItemNotFound
+		}
+		return domain.Item{}, fmt.Errorf("delete item: %w", err)
+	}
+
+	old := deleted
+	old.DeletedAt = nil
+	old.Version--
+	if err := insertHistory(ctx, tx, id, domain.HistoryActionDelete, &old, &deleted); err != nil {
+		return domain.Item{}, err
+	}
+	return deleted, nil
+}
+
+// ApplyBatch выполняет операции в одной транзакции: первая ошибка откатывает весь пакет
+// и возвращается как *domain.BatchOpError с индексом строки.
+func (r *ItemRepo) ApplyBatch(ctx context.Context, ops []domain.BatchOperation) ([]domain.BatchResult, error) {
+	results := make([]domain.BatchResult, 0, len(ops))
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) error {
+		for _, op := range ops {
+			res := domain.BatchResult{Index: op.Index, Op: op.Op}
+
+			var err error
+			switch op.Op {
+			case domain.BatchOpCreate:
+				var created domain.Item
+				created, err = createItemTx(ctx, tx, op.Item)
+				res.Item = &created
+			case domain.BatchOpUpdate:
+				var updated domain.Item
+				updated, err = updateItemTx(ctx, tx, op.Item)
+				res.Item = &updated
+			case domain.BatchOpDelete:
+				err = deleteItemTx(ctx, tx, op.Item.ID)
+			default:
+				err = domain.ErrInvalidBatchOp
+			}
+			if err != nil {
+				return &domain.BatchOpError{Index: op.Index, Err: err}
+			}
 
-	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+			results = append(results, res)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("delete item: %w", err)
+		return nil, err
 	}
 
-	affected, err := res.RowsAffected()
+	return results, nil
+}
+
+// Restore возвращает запись из корзины; половина перевода возвращается вместе со второй половиной.
+func (r *ItemRepo) Restore(ctx context.Context, id string) (domain.Item, error) {
+	var restored domain.Item
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) (err error) {
+		if restored, err = restoreItemTx(ctx, tx, id); err != nil || restored.TransferID == nil {
+			return err
+		}
+
+		legs, err := transferLegIDsTx(ctx, tx, *restored.TransferID, id, true)
+		if err != nil {
+			return err
+		}
+		for _, legID := range legs {
+			if _, err = restoreItemTx(ctx, tx, legID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("rows affected: %w", err)
+		return domain.Item{}, err
 	}
-	if affected == 0 {
-		return domain.ErrItemNotFound
+
+	return restored, nil
+}
+
+func restoreItemTx(ctx context.Context, tx *sql.Tx, id string) (domain.Item, error) {
+	selectQuery := `SELECT ` + itemColumns + ` FROM items WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`
+	updateQuery := `
+		UPDATE items
+		SET deleted_at = NULL, version = version + 1
+		WHERE id = $1
+		RETURNING ` + itemColumns
+
+	var old domain.Item
+	if err := scanItem(tx.QueryRowContext(ctx, selectQuery, id), &old); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Item{}, domain.ErrItemNotFound
+		}
+		return domain.Item{}, fmt.Errorf("select item for restore: %w", err)
 	}
 
-	return nil
+	var restored domain.Item
+	if err := scanItem(tx.QueryRowContext(ctx, updateQuery, id), &restored); err != nil {
+		return domain.Item{}, fmt.Errorf("restore item: %w", err)
+	}
+
+	if err := insertHistory(ctx, tx, id, domain.HistoryActionRestore, &old, &restored); err != nil {
+		return domain.Item{}, err
+	}
+	return restored, nil
+}
+
+// Purge окончательно удаляет записи, попавшие в корзину раньше olderThan, вместе с их вложениями.
+// Возвращает число удалённых записей и ключи файлов вложений, которые осталось удалить из хранилища.
+func (r *ItemRepo) Purge(ctx context.Context, olderThan time.Time) (int64, []string, error) {
+	query := `
+		DELETE FROM items
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1
+		RETURNING ` + itemColumns
+
+	var (
+		purged []domain.Item
+		keys   []string
+	)
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) (err error) {
+		if keys, err = purgeAttachmentsTx(ctx, tx, olderThan); err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, query, olderThan)
+		if err != nil {
+			return fmt.Errorf("purge items: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var i domain.Item
+			if err = scanItem(rows, &i); err != nil {
+				return fmt.Errorf("scan item: %w", err)
+			}
+			purged = append(purged, i)
+		}
+		if err = rows.Err(); err != nil {
+			return fmt.Errorf("rows iteration: %w", err)
+		}
+		// история пишется после закрытия курсора: драйвер не допускает параллельных запросов в транзакции
+		rows.Close()
+
+		for idx := range purged {
+			if err = insertHistory(ctx, tx, purged[idx].ID, domain.HistoryActionPurge, &purged[idx], nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return int64(len(purged)), keys, nil
 }
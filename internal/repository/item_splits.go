@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+// splitsColumn — разбивка записи JSON-массивом в порядке строк; items должна быть доступна по имени.
+const splitsColumn = `COALESCE((
+	SELECT json_agg(json_build_object('category', s.category, 'amount', s.amount) ORDER BY s.position)
+	FROM item_splits s WHERE s.item_id = items.id), '[]') AS splits`
+
+// splitList читает splitsColumn.
+type splitList []domain.ItemSplit
+
+func (l *splitList) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported splits type %T", src)
+	}
+	return json.Unmarshal(data, (*[]domain.ItemSplit)(l))
+}
+
+// setItemSplitsTx заменяет разбивку записи.
+func setItemSplitsTx(ctx context.Context, tx *sql.Tx, itemID string, splits []domain.ItemSplit) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM item_splits WHERE item_id = $1`, itemID); err != nil {
+		return fmt.Errorf("clear item splits: %w", err)
+	}
+	if len(splits) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(splits))
+	args := make([]interface{}, 0, len(splits)*2+1)
+	args = append(args, itemID)
+	for i, s := range splits {
+		values = append(values, fmt.Sprintf("($1, %d, $%d, $%d)", i, len(args)+1, len(args)+2))
+		args = append(args, s.Category, s.Amount)
+	}
+
+	query := `INSERT INTO item_splits (item_id, position, category, amount) VALUES ` + strings.Join(values, ", ")
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert item splits: %w", err)
+	}
+	return nil
+}
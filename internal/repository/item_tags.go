@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+// tagsColumn — теги записи одним массивом в алфавитном порядке; items должна быть доступна по имени.
+const tagsColumn = `ARRAY(
+	SELECT t.name FROM item_tags it JOIN tags t ON t.id = it.tag_id
+	WHERE it.item_id = items.id ORDER BY t.name) AS tags`
+
+// setItemTagsTx заменяет набор тегов записи; недостающие теги создаются.
+func setItemTagsTx(ctx context.Context, tx *sql.Tx, itemID string, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM item_tags WHERE item_id = $1`, itemID); err != nil {
+		return fmt.Errorf("clear item tags: %w", err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO tags (name) SELECT unnest($1::text[]) ON CONFLICT (name) DO NOTHING`,
+		pq.Array(tags),
+	); err != nil {
+		return fmt.Errorf("insert tags: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO item_tags (item_id, tag_id) SELECT $1, id FROM tags WHERE name = ANY($2)`,
+		itemID, pq.Array(tags),
+	); err != nil {
+		return fmt.Errorf("insert item tags: %w", err)
+	}
+	return nil
+}
+
+// tagFilterClause — условие на теги записи; список тегов передаётся массивом в параметре $argIdx.
+func tagFilterClause(match string, argIdx, count int) string {
+	if match == domain.TagMatchAll {
+		return fmt.Sprintf(`(
+			SELECT COUNT(DISTINCT t.name) FROM item_tags it JOIN tags t ON t.id = it.tag_id
+			WHERE it.item_id = items.id AND t.name = ANY($%d)) = %d`, argIdx, count)
+	}
+	return fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM item_tags it JOIN tags t ON t.id = it.tag_id
+		WHERE it.item_id = items.id AND t.name = ANY($%d))`, argIdx)
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+const rateColumns = "id, date, from_currency, to_currency, rate, created_at, updated_at"
+
+const pgUniqueViolation = "23505"
+
+type RateRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewRateRepo(db *dbpg.DB, strategy retry.Strategy) *RateRepo {
+	return &RateRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+func scanRate(row rowScanner, rate *domain.ExchangeRate) error {
+	return row.Scan(
+		&rate.ID, &rate.Date, &rate.From, &rate.To,
+		&rate.Rate, &rate.CreatedAt, &rate.UpdatedAt,
+	)
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation
+}
+
+func (r *RateRepo) Create(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	query := `
+		INSERT INTO exchange_rates (date, from_currency, to_currency, rate, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + rateColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		rate.Date, rate.From, rate.To, rate.Rate, rate.CreatedAt, rate.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ExchangeRate{}, domain.ErrRateExists
+		}
+		return domain.ExchangeRate{}, fmt.Errorf("create exchange rate: %w", err)
+	}
+
+	var created domain.ExchangeRate
+	if err = scanRate(row, &created); err != nil {
+		if isUniqueViolation(err) {
+			return domain.ExchangeRate{}, domain.ErrRateExists
+		}
+		return domain.ExchangeRate{}, fmt.Errorf("scan created exchange rate: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *RateRepo) GetByID(ctx context.Context, id string) (domain.ExchangeRate, error) {
+	query := `SELECT ` + rateColumns + ` FROM exchange_rates WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return domain.ExchangeRate{}, fmt.Errorf("get exchange rate by id: %w", err)
+	}
+
+	var rate domain.ExchangeRate
+	if err = scanRate(row, &rate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ExchangeRate{}, domain.ErrRateNotFound
+		}
+		return domain.ExchangeRate{}, fmt.Errorf("scan exchange rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+func (r *RateRepo) GetAll(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error) {
+	whereClauses := make([]string, 0, 4)
+	args := make([]interface{}, 0, 4)
+
+	if filter.FromCurrency != "" {
+		args = append(args, filter.FromCurrency)
+		whereClauses = append(whereClauses, fmt.Sprintf("from_currency = $%d", len(args)))
+	}
+	if filter.ToCurrency != "" {
+		args = append(args, filter.ToCurrency)
+		whereClauses = append(whereClauses, fmt.Sprintf("to_currency = $%d", len(args)))
+	}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		whereClauses = append(whereClauses, fmt.Sprintf("date >= $%d", len(args)))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		whereClauses = append(whereClauses, fmt.Sprintf("date <= $%d", len(args)))
+	}
+
+	where := ""
+	if len(whereClauses) > 0 {
+		where = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM exchange_rates %s
+		ORDER BY date DESC, from_currency, to_currency`, rateColumns, where)
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get all exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []domain.ExchangeRate
+	for rows.Next() {
+		var rate domain.ExchangeRate
+		if err = scanRate(rows, &rate); err != nil {
+			return nil, fmt.Errorf("scan exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return rates, nil
+}
+
+func (r *RateRepo) Update(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	query := `
+		UPDATE exchange_rates
+		SET date = $2, from_currency = $3, to_currency = $4, rate = $5, updated_at = $6
+		WHERE id = $1
+		RETURNING ` + rateColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		rate.ID, rate.Date, rate.From, rate.To, rate.Rate, rate.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ExchangeRate{}, domain.ErrRateExists
+		}
+		return domain.ExchangeRate{}, fmt.Errorf("update exchange rate: %w", err)
+	}
+
+	var updated domain.ExchangeRate
+	if err = scanRate(row, &updated); err != nil {
+		if isUniqueViolation(err) {
+			return domain.ExchangeRate{}, domain.ErrRateExists
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ExchangeRate{}, domain.ErrRateNotFound
+		}
+		return domain.ExchangeRate{}, fmt.Errorf("scan updated exchange rate: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (r *RateRepo) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM exchange_rates WHERE id = $1`
+
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return fmt.Errorf("delete exchange rate: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrRateNotFound
+	}
+
+	return nil
+}
+
+// Upsert сохраняет курсы одной транзакцией; курс на ту же дату и пару перезаписывается.
+func (r *RateRepo) Upsert(ctx context.Context, rates []domain.ExchangeRate) (int, error) {
+	query := `
+		INSERT INTO exchange_rates (date, from_currency, to_currency, rate, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (from_currency, to_currency, date)
+		DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at`
+
+	err := r.db.WithTxWithRetry(ctx, r.strategy, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("prepare upsert: %w", err)
+		}
+		defer stmt.Close()
+
+		for _, rate := range rates {
+			if _, err = stmt.ExecContext(ctx,
+				rate.Date, rate.From, rate.To, rate.Rate, rate.CreatedAt, rate.UpdatedAt,
+			); err != nil {
+				return fmt.Errorf("upsert exchange rate %s %s/%s: %w",
+					rate.Date.Format("2006-01-02"), rate.From, rate.To, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("upsert exchange rates: %w", err)
+	}
+
+	return len(rates), nil
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+const recurringColumns = `id, type, amount, currency, category, description, frequency, freq_interval,
+	start_date, end_date, occurrence_count, next_run, paused, created_at, updated_at`
+
+type RecurringRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewRecurringRepo(db *dbpg.DB, strategy retry.Strategy) *RecurringRepo {
+	return &RecurringRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+func scanRecurring(row rowScanner, rec *domain.RecurringItem) error {
+	return row.Scan(
+		&rec.ID, &rec.Type, &rec.Amount, &rec.Currency, &rec.Category, &rec.Description,
+		&rec.Frequency, &rec.Interval, &rec.StartDate, &rec.EndDate,
+		&rec.OccurrenceCount, &rec.NextRun, &rec.Paused, &rec.CreatedAt, &rec.UpdatedAt,
+	)
+}
+
+func (r *RecurringRepo) Create(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error) {
+	query := `
+		INSERT INTO recurring_items (type, amount, currency, category, description, frequency, freq_interval,
+			start_date, end_date, occurrence_count, next_run, paused, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING ` + recurringColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query,
+		rec.Type, rec.Amount, rec.Currency, rec.Category, rec.Description, rec.Frequency, rec.Interval,
+		rec.StartDate, rec.EndDate, rec.OccurrenceCount, rec.NextRun, rec.Paused, rec.CreatedAt, rec.UpdatedAt,
+	)
+	if err != nil {
+		return domain.RecurringItem{}, fmt.Errorf("create recurring item: %w", err)
+	}
+
+	var created domain.RecurringItem
+	if err = scanRecurring(row, &created); err != nil {
+		return domain.RecurringItem{}, fmt.Errorf("scan recurring item: %w", err)
+	}
+	return created, nil
+}
+
+func (r *RecurringRepo) GetByID(ctx context.Context, id string) (domain.RecurringItem, error) {
+	query := `SELECT ` + recurringColumns + ` FROM recurring_items WHERE id = $1`
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return domain.RecurringItem{}, fmt.Errorf("get recurring item by id: %w", err)
+	}
+
+	var rec domain.RecurringItem
+	if err = scanRecurring(row, &rec); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RecurringItem{}, domain.ErrRecurringNotFound
+		}
+		return domain.RecurringItem{}, fmt.Errorf("scan recurring item: %w", err)
+	}
+	return rec, nil
+}
+
+func (r *RecurringRepo) GetAll(ctx context.Context) ([]domain.RecurringItem, error) {
+	query := `SELECT ` + recurringColumns + ` FROM recurring_items ORDER BY next_run NULLS LAST, created_at`
+
+	return r.query(ctx, query)
+}
+
+// ListDue возвращает активные шаблоны, у которых очередное повторение наступило к today.
+func (r *RecurringRepo) ListDue(ctx context.Context, today time.Time) ([]domain.RecurringItem, error) {
+	query := `
+		SELECT ` + recurringColumns + `
+		FROM recurring_items
+		WHERE NOT paused AND next_run <= $1
+		ORDER BY next_run`
+
+	return r.query(ctx, query, today)
+}
+
+func (r *RecurringRepo) query(ctx context.Context, query string, args ...interface{}) ([]domain.RecurringItem, error) {
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get recurring items: %w", err)
+	}
+	defer rows.Close()
+
+	var recs []domain.RecurringItem
+	for rows.Next() {
+		var rec domain.RecurringItem
+		if err = scanRecurring(rows, &rec); err != nil {
+			return nil, fmt.Errorf("scan recurring item: %w", err)
+		}
+		recs = append(recs, rec)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return recs, nil
+}
+
+// UpdateSchedule сохраняет состояние серии (паузу, счётчик повторений, следующую дату).
+func (r *RecurringRepo) UpdateSchedule(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error) {
+	query := `
+		UPDATE recurring_items
+		SET paused = $2, occurrence_count = $3, next_run = $4, updated_at = now()
+		WHERE id = $1
+		RETURNING ` + recurringColumns
+
+	row, err := r.db.QueryRowWithRetry(ctx, r.strategy, query, rec.ID, rec.Paused, rec.OccurrenceCount, rec.NextRun)
+	if err != nil {
+		return domain.RecurringItem{}, fmt.Errorf("update recurring schedule: %w", err)
+	}
+
+	var updated domain.RecurringItem
+	if err = scanRecurring(row, &updated); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RecurringItem{}, domain.ErrRecurringNotFound
+		}
+		return domain.RecurringItem{}, fmt.Errorf("scan recurring item: %w", err)
+	}
+	return updated, nil
+}
+
+// Advance сдвигает серию с fromCount на toCount обработанных повторений. Условие на fromCount
+// не даёт двум экземплярам планировщика сдвинуть серию дважды; false — серию уже сдвинули.
+func (r *RecurringRepo) Advance(
+	ctx context.Context, id string, fromCount, toCount int, nextRun *time.Time,
+) (bool, error) {
+	query := `
+		UPDATE recurring_items
+		SET occurrence_count = $3, next_run = $4, updated_at = now()
+		WHERE id = $1 AND occurrence_count = $2`
+
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id, fromCount, toCount, nextRun)
+	if err != nil {
+		return false, fmt.Errorf("advance recurring item: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *RecurringRepo) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM recurring_items WHERE id = $1`
+
+	res, err := r.db.ExecWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return fmt.Errorf("delete recurring item: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrRecurringNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+type TransferRepo struct {
+	db       *dbpg.DB
+	strategy retry.Strategy
+}
+
+func NewTransferRepo(db *dbpg.DB, strategy retry.Strategy) *TransferRepo {
+	return &TransferRepo{
+		db:       db,
+		strategy: strategy,
+	}
+}
+
+// Create записывает обе половины перевода одной транзакцией под общим transfer_id.
+func (r *TransferRepo) Create(ctx context.Context, from, to domain.Item) (domain.Transfer, error) {
+	var transfer domain.Transfer
+	err := withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) (err error) {
+		if err = tx.QueryRowContext(ctx, `SELECT gen_random_uuid()`).Scan(&transfer.ID); err != nil {
+			return fmt.Errorf("generate transfer id: %w", err)
+		}
+
+		from.TransferID = &transfer.ID
+		to.TransferID = &transfer.ID
+		if transfer.From, err = createItemTx(ctx, tx, from); err != nil {
+			return err
+		}
+		transfer.To, err = createItemTx(ctx, tx, to)
+		return err
+	})
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (r *TransferRepo) GetByID(ctx context.Context, id string) (domain.Transfer, error) {
+	// расход (половина счёта-источника) идёт первым
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items
+		WHERE transfer_id = $1 AND deleted_at IS NULL
+		ORDER BY type = 'expense' DESC`
+
+	rows, err := r.db.QueryWithRetry(ctx, r.strategy, query, id)
+	if err != nil {
+		return domain.Transfer{}, fmt.Errorf("get transfer: %w", err)
+	}
+	defer rows.Close()
+
+	var legs []domain.Item
+	for rows.Next() {
+		var i domain.Item
+		if err = scanItem(rows, &i); err != nil {
+			return domain.Transfer{}, fmt.Errorf("scan transfer item: %w", err)
+		}
+		legs = append(legs, i)
+	}
+	if err = rows.Err(); err != nil {
+		return domain.Transfer{}, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	if len(legs) != 2 {
+		return domain.Transfer{}, domain.ErrTransferNotFound
+	}
+	return domain.Transfer{ID: id, From: legs[0], To: legs[1]}, nil
+}
+
+// Delete переносит обе половины перевода в корзину.
+func (r *TransferRepo) Delete(ctx context.Context, id string) error {
+	query := `SELECT id FROM items WHERE transfer_id = $1 AND deleted_at IS NULL LIMIT 1`
+
+	return withTx(ctx, r.db, r.strategy, func(tx *sql.Tx) error {
+		var legID string
+		if err := tx.QueryRowContext(ctx, query, id).Scan(&legID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrTransferNotFound
+			}
+			return fmt.Errorf("select transfer item: %w", err)
+		}
+		return deleteItemTx(ctx, tx, legID)
+	})
+}
+
+// transferLegIDsTx возвращает остальные половины перевода transferID, кроме записи exceptID:
+// из корзины при deleted, иначе живые.
+func transferLegIDsTx(ctx context.Context, tx *sql.Tx, transferID, exceptID string, deleted bool) ([]string, error) {
+	query := `
+		SELECT id FROM items
+		WHERE transfer_id = $1 AND id <> $2 AND (deleted_at IS NOT NULL) = $3
+		FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, transferID, exceptID, deleted)
+	if err != nil {
+		return nil, fmt.Errorf("select transfer items: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan transfer item id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+	return ids, nil
+}
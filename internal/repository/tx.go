@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/wb-go/wbf/dbpg"
+	"github.com/wb-go/wbf/retry"
+)
+
+// withTx выполняет fn в транзакции. Ретраится только открытие транзакции: доменные ошибки
+// из fn (например, ErrItemNotFound) повторять бессмысленно.
+func withTx(ctx context.Context, db *dbpg.DB, strategy retry.Strategy, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTxWithRetry(ctx, strategy, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
@@ -10,23 +10,93 @@ type itemHandler interface {
 	Create(c *ginext.Context)
 	List(c *ginext.Context)
 	Update(c *ginext.Context)
+	Patch(c *ginext.Context)
+	Batch(c *ginext.Context)
 	Delete(c *ginext.Context)
 	GetByID(c *ginext.Context)
+	History(c *ginext.Context)
+	Restore(c *ginext.Context)
+	Trash(c *ginext.Context)
+	Purge(c *ginext.Context)
 }
 
 type analyticsHandler interface {
 	Get(c *ginext.Context)
+	Cashflow(c *ginext.Context)
+	CSV(c *ginext.Context)
 }
 
 type exportHandler interface {
 	CSV(c *ginext.Context)
 }
 
+type importHandler interface {
+	CSV(c *ginext.Context)
+}
+
+type categoryHandler interface {
+	Create(c *ginext.Context)
+	List(c *ginext.Context)
+	GetByID(c *ginext.Context)
+	Update(c *ginext.Context)
+	Delete(c *ginext.Context)
+}
+
+type accountHandler interface {
+	Create(c *ginext.Context)
+	List(c *ginext.Context)
+	GetByID(c *ginext.Context)
+	Update(c *ginext.Context)
+	Delete(c *ginext.Context)
+	Balance(c *ginext.Context)
+	Statement(c *ginext.Context)
+}
+
+type attachmentHandler interface {
+	Upload(c *ginext.Context)
+	List(c *ginext.Context)
+	Download(c *ginext.Context)
+	Delete(c *ginext.Context)
+}
+
+type transferHandler interface {
+	Create(c *ginext.Context)
+	GetByID(c *ginext.Context)
+	Delete(c *ginext.Context)
+}
+
+type recurringHandler interface {
+	Create(c *ginext.Context)
+	List(c *ginext.Context)
+	GetByID(c *ginext.Context)
+	Pause(c *ginext.Context)
+	Resume(c *ginext.Context)
+	Delete(c *ginext.Context)
+	Preview(c *ginext.Context)
+}
+
+type rateHandler interface {
+	Create(c *ginext.Context)
+	List(c *ginext.Context)
+	GetByID(c *ginext.Context)
+	Update(c *ginext.Context)
+	Delete(c *ginext.Context)
+	Import(c *ginext.Context)
+}
+
 func InitRouter(
 	mode string,
 	itemHandler itemHandler,
 	analyticsHandler analyticsHandler,
 	exportHandler exportHandler,
+	importHandler importHandler,
+	rateHandler rateHandler,
+	recurringHandler recurringHandler,
+	categoryHandler categoryHandler,
+	accountHandler accountHandler,
+	transferHandler transferHandler,
+	attachmentHandler attachmentHandler,
+	idempotency ginext.HandlerFunc,
 	mw ...ginext.HandlerFunc,
 ) *ginext.Engine {
 	router := ginext.New(mode)
@@ -35,15 +105,62 @@ func InitRouter(
 
 	api := router.Group("/api")
 	{
-		api.POST("/items", itemHandler.Create)
+		api.POST("/items", idempotency, itemHandler.Create)
 		api.GET("/items", itemHandler.List)
+		api.POST("/items/batch", idempotency, itemHandler.Batch)
 		api.GET("/items/:id", itemHandler.GetByID)
 		api.PUT("/items/:id", itemHandler.Update)
+		api.PATCH("/items/:id", itemHandler.Patch)
 		api.DELETE("/items/:id", itemHandler.Delete)
+		api.GET("/items/:id/history", itemHandler.History)
+		api.POST("/items/:id/restore", itemHandler.Restore)
+		api.POST("/items/:id/attachments", attachmentHandler.Upload)
+		api.GET("/items/:id/attachments", attachmentHandler.List)
+		api.GET("/items/:id/attachments/:attachment_id", attachmentHandler.Download)
+		api.DELETE("/items/:id/attachments/:attachment_id", attachmentHandler.Delete)
+
+		api.GET("/trash", itemHandler.Trash)
+		api.DELETE("/trash", itemHandler.Purge)
 
 		api.GET("/analytics", analyticsHandler.Get)
+		api.GET("/analytics/cashflow", analyticsHandler.Cashflow)
 
 		api.GET("/export/csv", exportHandler.CSV)
+		api.GET("/export/analytics/csv", analyticsHandler.CSV)
+		api.POST("/import/csv", importHandler.CSV)
+
+		api.POST("/rates", rateHandler.Create)
+		api.POST("/rates/import", rateHandler.Import)
+		api.GET("/rates", rateHandler.List)
+		api.GET("/rates/:id", rateHandler.GetByID)
+		api.PUT("/rates/:id", rateHandler.Update)
+		api.DELETE("/rates/:id", rateHandler.Delete)
+
+		api.POST("/categories", categoryHandler.Create)
+		api.GET("/categories", categoryHandler.List)
+		api.GET("/categories/:id", categoryHandler.GetByID)
+		api.PUT("/categories/:id", categoryHandler.Update)
+		api.DELETE("/categories/:id", categoryHandler.Delete)
+
+		api.POST("/accounts", accountHandler.Create)
+		api.GET("/accounts", accountHandler.List)
+		api.GET("/accounts/:id", accountHandler.GetByID)
+		api.PUT("/accounts/:id", accountHandler.Update)
+		api.DELETE("/accounts/:id", accountHandler.Delete)
+		api.GET("/accounts/:id/balance", accountHandler.Balance)
+		api.GET("/accounts/:id/statement", accountHandler.Statement)
+
+		api.POST("/transfers", idempotency, transferHandler.Create)
+		api.GET("/transfers/:id", transferHandler.GetByID)
+		api.DELETE("/transfers/:id", transferHandler.Delete)
+
+		api.POST("/recurring", recurringHandler.Create)
+		api.GET("/recurring", recurringHandler.List)
+		api.GET("/recurring/:id", recurringHandler.GetByID)
+		api.DELETE("/recurring/:id", recurringHandler.Delete)
+		api.POST("/recurring/:id/pause", recurringHandler.Pause)
+		api.POST("/recurring/:id/resume", recurringHandler.Resume)
+		api.GET("/recurring/:id/preview", recurringHandler.Preview)
 	}
 
 	router.GET("/health", func(c *ginext.Context) {
@@ -0,0 +1,97 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wb-go/wbf/logger"
+)
+
+type recurringRunner interface {
+	RunDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// expiredCleaner удаляет устаревшие служебные данные, например истёкшие ключи идемпотентности.
+type expiredCleaner interface {
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// Scheduler периодически создаёт записи по наступившим повторениям и удаляет истёкшие ключи идемпотентности.
+type Scheduler struct {
+	runner   recurringRunner
+	cleaner  expiredCleaner
+	interval time.Duration
+	log      logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(runner recurringRunner, cleaner expiredCleaner, interval time.Duration, log logger.Logger) *Scheduler {
+	return &Scheduler{
+		runner:   runner,
+		cleaner:  cleaner,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Start запускает цикл в отдельной горутине; первый проход выполняется сразу.
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	s.log.LogAttrs(ctx, logger.InfoLevel, "scheduler started",
+		logger.Duration("interval", s.interval))
+}
+
+// Stop отменяет текущий проход и ждёт завершения горутины.
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+
+	s.log.LogAttrs(context.Background(), logger.InfoLevel, "scheduler stopped")
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	created, err := s.runner.RunDue(ctx, time.Now())
+	if err != nil && ctx.Err() == nil {
+		s.log.LogAttrs(ctx, logger.ErrorLevel, "run recurring items",
+			logger.String("error", err.Error()))
+	}
+	if created > 0 {
+		s.log.LogAttrs(ctx, logger.InfoLevel, "recurring items materialized",
+			logger.Int("created", created))
+	}
+
+	deleted, err := s.cleaner.DeleteExpired(ctx)
+	if err != nil && ctx.Err() == nil {
+		s.log.LogAttrs(ctx, logger.ErrorLevel, "delete expired idempotency keys",
+			logger.String("error", err.Error()))
+	}
+	if deleted > 0 {
+		s.log.LogAttrs(ctx, logger.InfoLevel, "expired idempotency keys deleted",
+			logger.Int64("deleted", deleted))
+	}
+}
@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/wb-go/wbf/logger"
+)
+
+func TestScheduler_StartRunsImmediatelyAndStopWaits(t *testing.T) {
+	log, err := logger.InitLogger(logger.SlogEngine, "test", "test")
+	require.NoError(t, err)
+
+	runner := newMockrecurringRunner(t)
+	called := make(chan struct{}, 1)
+	runner.EXPECT().RunDue(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, time.Time) (int, error) {
+			select {
+			case called <- struct{}{}:
+			default:
+			}
+			return 1, nil
+		})
+
+	cleaner := newMockexpiredCleaner(t)
+	cleaner.EXPECT().DeleteExpired(mock.Anything).Return(0, nil)
+
+	s := New(runner, cleaner, time.Hour, log)
+	s.Start()
+
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("RunDue was not called on start")
+	}
+
+	s.Stop()
+}
+
+func TestScheduler_DeletesExpiredKeysAfterRunError(t *testing.T) {
+	log, err := logger.InitLogger(logger.SlogEngine, "test", "test")
+	require.NoError(t, err)
+
+	runner := newMockrecurringRunner(t)
+	runner.EXPECT().RunDue(mock.Anything, mock.Anything).Return(0, errors.New("connection refused"))
+	cleaner := newMockexpiredCleaner(t)
+	cleaner.EXPECT().DeleteExpired(mock.Anything).Return(3, nil).Once()
+
+	// ошибка повторяющихся операций не мешает очистке ключей
+	New(runner, cleaner, time.Hour, log).tick(context.Background())
+}
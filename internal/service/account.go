@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/helpers"
+)
+
+type accountRepository interface {
+	Create(ctx context.Context, acc domain.Account) (domain.Account, error)
+	GetByID(ctx context.Context, id string) (domain.Account, error)
+	GetAll(ctx context.Context, includeClosed bool) ([]domain.Account, error)
+	Update(ctx context.Context, acc domain.Account) (domain.Account, error)
+	Delete(ctx context.Context, id string) error
+	HasItems(ctx context.Context, id string) (bool, error)
+	Balance(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error)
+	Statement(ctx context.Context, filter domain.StatementFilter) ([]domain.StatementEntry, int64, error)
+}
+
+type AccountService struct {
+	repo accountRepository
+}
+
+func NewAccountService(repo accountRepository) *AccountService {
+	return &AccountService{repo: repo}
+}
+
+func (s *AccountService) Create(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	created, err := s.repo.Create(ctx, acc)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return created, nil
+}
+
+func (s *AccountService) List(ctx context.Context, includeClosed bool) ([]domain.Account, error) {
+	accounts, err := s.repo.GetAll(ctx, includeClosed)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (s *AccountService) GetByID(ctx context.Context, id string) (domain.Account, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.Account{}, domain.ErrInvalidID
+	}
+
+	acc, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return acc, nil
+}
+
+// Update меняет счёт. Валюту счёта с записями менять нельзя: записи ведутся в валюте счёта.
+func (s *AccountService) Update(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	old, err := s.GetByID(ctx, acc.ID)
+	if err != nil {
+		return domain.Account{}, err
+	}
+
+	if old.Currency != acc.Currency {
+		hasItems, err := s.repo.HasItems(ctx, acc.ID)
+		if err != nil {
+			return domain.Account{}, err
+		}
+		if hasItems {
+			return domain.Account{}, domain.ErrAccountInUse
+		}
+	}
+
+	updated, err := s.repo.Update(ctx, acc)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return updated, nil
+}
+
+func (s *AccountService) Delete(ctx context.Context, id string) error {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.ErrInvalidID
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Balance возвращает остаток счёта на конец дня at.
+func (s *AccountService) Balance(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.AccountBalance{}, domain.ErrInvalidID
+	}
+
+	balance, err := s.repo.Balance(ctx, id, at)
+	if err != nil {
+		return domain.AccountBalance{}, err
+	}
+	return balance, nil
+}
+
+// Statement возвращает счёт и его записи с нарастающим остатком.
+func (s *AccountService) Statement(
+	ctx context.Context, filter domain.StatementFilter,
+) (domain.Account, []domain.StatementEntry, int64, error) {
+	if err := filter.Validate(); err != nil {
+		return domain.Account{}, nil, 0, err
+	}
+
+	acc, err := s.GetByID(ctx, filter.AccountID)
+	if err != nil {
+		return domain.Account{}, nil, 0, err
+	}
+
+	entries, total, err := s.repo.Statement(ctx, filter)
+	if err != nil {
+		return domain.Account{}, nil, 0, err
+	}
+	return acc, entries, total, nil
+}
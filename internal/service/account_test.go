@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAccount() domain.Account {
+	return domain.Account{ID: validUUID, Name: "card", Currency: "RUB", OpeningBalance: decimal.NewFromInt(1000)}
+}
+
+func TestAccountService_Update_CurrencyWithItems(t *testing.T) {
+	repo := newMockaccountRepository(t)
+	svc := NewAccountService(repo)
+
+	input := newTestAccount()
+	input.Currency = "USD"
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestAccount(), nil)
+	repo.EXPECT().HasItems(mock.Anything, validUUID).Return(true, nil)
+
+	_, err := svc.Update(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrAccountInUse)
+}
+
+func TestAccountService_Update_SameCurrency(t *testing.T) {
+	repo := newMockaccountRepository(t)
+	svc := NewAccountService(repo)
+
+	input := newTestAccount()
+	input.Name = "debit card"
+	input.Closed = true
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestAccount(), nil)
+	repo.EXPECT().Update(mock.Anything, input).Return(input, nil)
+
+	result, err := svc.Update(context.Background(), input)
+	require.NoError(t, err)
+	assert.True(t, result.Closed)
+}
+
+func TestAccountService_Balance_InvalidUUID(t *testing.T) {
+	repo := newMockaccountRepository(t)
+	svc := NewAccountService(repo)
+
+	_, err := svc.Balance(context.Background(), "bad", time.Now())
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestAccountService_Statement_Success(t *testing.T) {
+	repo := newMockaccountRepository(t)
+	svc := NewAccountService(repo)
+
+	filter := domain.StatementFilter{AccountID: validUUID}
+	entries := []domain.StatementEntry{
+		{Item: newTestItem(), Balance: decimal.NewFromInt(2000)},
+	}
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestAccount(), nil)
+	repo.EXPECT().Statement(mock.Anything, filter).Return(entries, int64(1), nil)
+
+	acc, result, total, err := svc.Statement(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Equal(t, "card", acc.Name)
+	assert.Equal(t, entries, result)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestAccountService_Statement_InvalidRange(t *testing.T) {
+	repo := newMockaccountRepository(t)
+	svc := NewAccountService(repo)
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, _, _, err := svc.Statement(context.Background(), domain.StatementFilter{AccountID: validUUID, From: &from, To: &to})
+	assert.ErrorIs(t, err, domain.ErrInvalidDateRange)
+}
+
+func TestAccountService_Statement_NotFound(t *testing.T) {
+	repo := newMockaccountRepository(t)
+	svc := NewAccountService(repo)
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(domain.Account{}, domain.ErrAccountNotFound)
+
+	_, _, _, err := svc.Statement(context.Background(), domain.StatementFilter{AccountID: validUUID})
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound)
+}
@@ -3,14 +3,21 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stpnv0/SalesTracker/internal/domain"
 )
 
 type analyticsRepository interface {
-	Aggregate(ctx context.Context, from, to time.Time, itemType string) (domain.AnalyticsResult, error)
-	AggregateGrouped(ctx context.Context, from, to time.Time, groupBy, itemType string) ([]domain.GroupedAnalytics, error)
+	Aggregate(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.AnalyticsResult, error)
+	AggregateGrouped(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.GroupedAnalytics, error)
+	AggregateCategoryTree(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CategoryTreeGroup, error)
+	AggregatePivot(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.PivotCell, error)
+	FindUnconverted(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.UnconvertedItem, error)
+	Cashflow(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CashflowBucket, error)
 }
 
 type AnalyticsService struct {
@@ -21,23 +28,303 @@ func NewAnalyticsService(repo analyticsRepository) *AnalyticsService {
 	return &AnalyticsService{repo: repo}
 }
 
-func (s *AnalyticsService) GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsResult, error) {
+func (s *AnalyticsService) GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsReport, error) {
 	if err := filter.Validate(); err != nil {
-		return domain.AnalyticsResult{}, fmt.Errorf("validate analytics filter: %w", err)
+		return domain.AnalyticsReport{}, fmt.Errorf("validate analytics filter: %w", err)
 	}
 
-	result, err := s.repo.Aggregate(ctx, filter.From, filter.To, filter.Type)
+	report, err := s.report(ctx, filter)
 	if err != nil {
-		return domain.AnalyticsResult{}, err
+		return domain.AnalyticsReport{}, err
+	}
+	if filter.Compare == domain.CompareNone {
+		return report, nil
+	}
+
+	prev := filter
+	prev.Compare = domain.CompareNone
+	prev.From, prev.To = filter.ComparisonPeriod()
+	prevReport, err := s.report(ctx, prev)
+	if err != nil {
+		return domain.AnalyticsReport{}, err
+	}
+
+	attachDeltas(report.Currencies, prevReport.Currencies,
+		groupMatchKey(filter, filter.From), groupMatchKey(filter, prev.From))
+	report.Comparison = &domain.AnalyticsComparison{
+		Compare:     filter.Compare,
+		From:        prev.From,
+		To:          prev.To,
+		Currencies:  prevReport.Currencies,
+		Unconverted: prevReport.Unconverted,
+	}
+	return report, nil
+}
+
+// report считает аналитику за период filter без учёта Compare.
+func (s *AnalyticsService) report(ctx context.Context, filter domain.AnalyticsFilter) (domain.AnalyticsReport, error) {
+	results, err := s.repo.Aggregate(ctx, filter)
+	if err != nil {
+		return domain.AnalyticsReport{}, err
 	}
 
-	if filter.GroupBy != "" {
-		groups, err := s.repo.AggregateGrouped(ctx, filter.From, filter.To, filter.GroupBy, filter.Type)
+	switch {
+	case filter.PivotBy != "":
+		cells, err := s.repo.AggregatePivot(ctx, filter)
+		if err != nil {
+			return domain.AnalyticsReport{}, err
+		}
+		attachPivots(results, filter, cells)
+	case filter.GroupBy == "":
+	case filter.GroupBy == domain.GroupByCategoryTree:
+		nodes, err := s.repo.AggregateCategoryTree(ctx, filter)
 		if err != nil {
-			return domain.AnalyticsResult{}, err
+			return domain.AnalyticsReport{}, err
 		}
-		result.Groups = groups
+		results = attachGroups(results, buildCategoryTree(nodes))
+	default:
+		groups, err := s.repo.AggregateGrouped(ctx, filter)
+		if err != nil {
+			return domain.AnalyticsReport{}, err
+		}
+		results = attachGroups(results, groups)
+	}
+
+	if results == nil {
+		results = []domain.AnalyticsResult{}
 	}
+	report := domain.AnalyticsReport{Currencies: results}
+
+	if filter.BaseCurrency != "" {
+		unconverted, err := s.repo.FindUnconverted(ctx, filter)
+		if err != nil {
+			return domain.AnalyticsReport{}, err
+		}
+		report.BaseCurrency = filter.BaseCurrency
+		report.Unconverted = unconverted
+	}
+
+	return report, nil
+}
+
+// GetCashflow возвращает доходы, расходы и их разницу по интервалам; по умолчанию — по месяцам.
+func (s *AnalyticsService) GetCashflow(ctx context.Context, filter domain.AnalyticsFilter) (domain.CashflowReport, error) {
+	if filter.GroupBy == "" {
+		filter.GroupBy = domain.GroupByMonth
+	}
+	if err := filter.ValidateCashflow(); err != nil {
+		return domain.CashflowReport{}, fmt.Errorf("validate cashflow filter: %w", err)
+	}
+
+	buckets, err := s.repo.Cashflow(ctx, filter)
+	if err != nil {
+		return domain.CashflowReport{}, err
+	}
+
+	// интервалы приходят упорядоченными по валюте, поэтому валюта собирается подряд
+	report := domain.CashflowReport{GroupBy: filter.GroupBy, Currencies: []domain.CashflowResult{}}
+	for _, b := range buckets {
+		n := len(report.Currencies)
+		if n == 0 || report.Currencies[n-1].Currency != b.Currency {
+			report.Currencies = append(report.Currencies, domain.CashflowResult{Currency: b.Currency})
+			n++
+		}
+		res := &report.Currencies[n-1]
+		res.Income = res.Income.Add(b.Income)
+		res.Expense = res.Expense.Add(b.Expense)
+		res.Net = res.Net.Add(b.Net)
+		res.Buckets = append(res.Buckets, b)
+	}
+
+	if filter.BaseCurrency != "" {
+		unconverted, err := s.repo.FindUnconverted(ctx, filter)
+		if err != nil {
+			return domain.CashflowReport{}, err
+		}
+		report.BaseCurrency = filter.BaseCurrency
+		report.Unconverted = unconverted
+	}
+
+	return report, nil
+}
+
+// attachGroups раскладывает группы по результатам их валюты, сохраняя порядок из репозитория.
+// Для валюты без результата (нулевой ряд fill=zero за период без записей) добавляется нулевой результат.
+func attachGroups(results []domain.AnalyticsResult, groups []domain.GroupedAnalytics) []domain.AnalyticsResult {
+	idx := make(map[string]int, len(results))
+	for i, r := range results {
+		idx[r.Currency] = i
+	}
+	for _, g := range groups {
+		i, ok := idx[g.Currency]
+		if !ok {
+			i = len(results)
+			idx[g.Currency] = i
+			results = append(results, domain.AnalyticsResult{Currency: g.Currency})
+		}
+		results[i].Groups = append(results[i].Groups, g)
+	}
+	return results
+}
+
+// attachPivots собирает ячейки в матрицы, по одной на валюту результата. Строки и столбцы
+// упорядочены по ключу; ячейки сочетаний, которых нет среди cells, остаются нулевыми.
+func attachPivots(results []domain.AnalyticsResult, filter domain.AnalyticsFilter, cells []domain.PivotCell) {
+	byCurrency := make(map[string][]domain.PivotCell, len(results))
+	for _, c := range cells {
+		byCurrency[c.Currency] = append(byCurrency[c.Currency], c)
+	}
+
+	for i := range results {
+		cur := byCurrency[results[i].Currency]
+		rows, rowIdx := pivotKeys(cur, func(c domain.PivotCell) string { return c.RowKey })
+		cols, colIdx := pivotKeys(cur, func(c domain.PivotCell) string { return c.ColumnKey })
 
-	return result, nil
+		matrix := make([][]domain.PivotCell, len(rows))
+		for r := range matrix {
+			matrix[r] = make([]domain.PivotCell, len(cols))
+		}
+		for _, c := range cur {
+			matrix[rowIdx[c.RowKey]][colIdx[c.ColumnKey]] = c
+		}
+
+		results[i].Pivot = &domain.AnalyticsPivot{
+			RowsBy:    filter.GroupBy,
+			ColumnsBy: filter.PivotBy,
+			Rows:      rows,
+			Columns:   cols,
+			Cells:     matrix,
+		}
+	}
+}
+
+// pivotKeys возвращает отсортированные различные ключи ячеек и индекс каждого из них.
+func pivotKeys(cells []domain.PivotCell, key func(domain.PivotCell) string) ([]string, map[string]int) {
+	idx := make(map[string]int)
+	keys := []string{}
+	for _, c := range cells {
+		if _, ok := idx[key(c)]; !ok {
+			idx[key(c)] = 0
+			keys = append(keys, key(c))
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		idx[k] = i
+	}
+	return keys, idx
+}
+
+// buildCategoryTree собирает плоский список категорий в деревья, отдельно для каждой валюты.
+// Порядок узлов на каждом уровне сохраняется из репозитория; категория, родителя которой
+// в той же валюте нет, становится корнем.
+func buildCategoryTree(nodes []domain.CategoryTreeGroup) []domain.GroupedAnalytics {
+	type nodeKey struct{ id, currency string }
+
+	children := make(map[nodeKey][]int, len(nodes))
+	present := make(map[nodeKey]bool, len(nodes))
+	for _, n := range nodes {
+		present[nodeKey{n.CategoryID, n.Currency}] = true
+	}
+
+	var roots []int
+	for i, n := range nodes {
+		if n.ParentID != nil && present[nodeKey{*n.ParentID, n.Currency}] {
+			parent := nodeKey{*n.ParentID, n.Currency}
+			children[parent] = append(children[parent], i)
+			continue
+		}
+		roots = append(roots, i)
+	}
+
+	var build func(i int) domain.GroupedAnalytics
+	build = func(i int) domain.GroupedAnalytics {
+		g := nodes[i].GroupedAnalytics
+		for _, c := range children[nodeKey{nodes[i].CategoryID, nodes[i].Currency}] {
+			g.Children = append(g.Children, build(c))
+		}
+		return g
+	}
+
+	tree := make([]domain.GroupedAnalytics, 0, len(roots))
+	for _, i := range roots {
+		tree = append(tree, build(i))
+	}
+	return tree
+}
+
+// attachDeltas проставляет результатам и их группам изменение относительно периода сравнения.
+// Результаты сопоставляются по валюте, группы — по ключу сопоставления (curKey для текущего
+// периода, prevKey для периода сравнения); чего нет в периоде сравнения, считается нулём.
+func attachDeltas(current, previous []domain.AnalyticsResult, curKey, prevKey func(string) string) {
+	prevByCurrency := make(map[string]domain.AnalyticsResult, len(previous))
+	for _, r := range previous {
+		prevByCurrency[r.Currency] = r
+	}
+	for i := range current {
+		prev := prevByCurrency[current[i].Currency]
+		current[i].Delta = newDelta(current[i].TotalSum, prev.TotalSum, current[i].Count, prev.Count)
+		attachGroupDeltas(current[i].Groups, prev.Groups, curKey, prevKey)
+	}
+}
+
+func attachGroupDeltas(current, previous []domain.GroupedAnalytics, curKey, prevKey func(string) string) {
+	prevByKey := make(map[string]domain.GroupedAnalytics, len(previous))
+	for _, g := range previous {
+		prevByKey[prevKey(g.Key)] = g
+	}
+	for i := range current {
+		prev := prevByKey[curKey(current[i].Key)]
+		current[i].Delta = newDelta(current[i].TotalSum, prev.TotalSum, current[i].Count, prev.Count)
+		attachGroupDeltas(current[i].Children, prev.Children, curKey, prevKey)
+	}
+}
+
+func newDelta(sum, prevSum decimal.Decimal, count, prevCount int64) *domain.AnalyticsDelta {
+	return &domain.AnalyticsDelta{
+		TotalSum:        sum.Sub(prevSum),
+		TotalSumPercent: percentChange(sum, prevSum),
+		Count:           count - prevCount,
+		CountPercent:    percentChange(decimal.NewFromInt(count), decimal.NewFromInt(prevCount)),
+	}
+}
+
+// percentChange — изменение в процентах с точностью до сотых; nil, если prev равно нулю.
+func percentChange(cur, prev decimal.Decimal) *decimal.Decimal {
+	if prev.IsZero() {
+		return nil
+	}
+	pct := cur.Sub(prev).Div(prev).Mul(decimal.NewFromInt(100)).Round(2)
+	return &pct
+}
+
+// groupMatchKey возвращает ключ, по которому сопоставляются группы двух периодов: категории
+// и теги — по имени, интервалы времени — по номеру интервала от начала периода from.
+func groupMatchKey(filter domain.AnalyticsFilter, from time.Time) func(key string) string {
+	if !domain.IsTimeGroupBy(filter.GroupBy) {
+		return func(key string) string { return key }
+	}
+
+	monthIndex := func(t time.Time) int { return t.Year()*12 + int(t.Month()) - 1 }
+	return func(key string) string {
+		t, err := time.Parse("2006-01-02", key)
+		if err != nil {
+			return key
+		}
+		var n int
+		switch filter.GroupBy {
+		case domain.GroupByDay:
+			n = int(t.Sub(from).Hours() / 24)
+		case domain.GroupByWeek:
+			weekStart := from.AddDate(0, 0, -(int(from.Weekday()-filter.WeekStartDay())+7)%7)
+			n = int(t.Sub(weekStart).Hours() / 24 / 7)
+		case domain.GroupByMonth:
+			n = monthIndex(t) - monthIndex(from)
+		case domain.GroupByQuarter:
+			n = monthIndex(t)/3 - monthIndex(from)/3
+		case domain.GroupByYear:
+			n = t.Year() - from.Year()
+		}
+		return strconv.Itoa(n)
+	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -19,6 +20,7 @@ var (
 
 func newTestAnalyticsResult() domain.AnalyticsResult {
 	return domain.AnalyticsResult{
+		Currency: "RUB",
 		TotalSum: decimal.NewFromInt(1000),
 		Avg:      decimal.NewFromInt(100),
 		Count:    10,
@@ -34,12 +36,13 @@ func TestAnalyticsService_GetAnalytics_Success(t *testing.T) {
 	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo}
 	expected := newTestAnalyticsResult()
 
-	repo.EXPECT().Aggregate(mock.Anything, analyticsFrom, analyticsTo, "").Return(expected, nil)
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{expected}, nil)
 
-	result, err := svc.GetAnalytics(context.Background(), filter)
+	report, err := svc.GetAnalytics(context.Background(), filter)
 	assert.NoError(t, err)
-	assert.Equal(t, expected.Count, result.Count)
-	assert.True(t, expected.TotalSum.Equal(result.TotalSum))
+	assert.Len(t, report.Currencies, 1)
+	assert.Equal(t, expected.Count, report.Currencies[0].Count)
+	assert.True(t, expected.TotalSum.Equal(report.Currencies[0].TotalSum))
 }
 
 func TestAnalyticsService_GetAnalytics_WithGroupBy(t *testing.T) {
@@ -54,18 +57,80 @@ func TestAnalyticsService_GetAnalytics_WithGroupBy(t *testing.T) {
 
 	aggregateResult := newTestAnalyticsResult()
 	groups := []domain.GroupedAnalytics{
-		{Key: "2024-01", TotalSum: decimal.NewFromInt(500), Count: 5},
-		{Key: "2024-02", TotalSum: decimal.NewFromInt(500), Count: 5},
+		{Key: "2024-01", Currency: "RUB", TotalSum: decimal.NewFromInt(500), Count: 5},
+		{Key: "2024-02", Currency: "RUB", TotalSum: decimal.NewFromInt(500), Count: 5},
 	}
 
-	repo.EXPECT().Aggregate(mock.Anything, analyticsFrom, analyticsTo, "").Return(aggregateResult, nil)
-	repo.EXPECT().AggregateGrouped(mock.Anything, analyticsFrom, analyticsTo, domain.GroupByMonth, "").Return(groups, nil)
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{aggregateResult}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return(groups, nil)
 
-	result, err := svc.GetAnalytics(context.Background(), filter)
+	report, err := svc.GetAnalytics(context.Background(), filter)
 	assert.NoError(t, err)
-	assert.Equal(t, aggregateResult.Count, result.Count)
-	assert.Len(t, result.Groups, 2)
-	assert.Equal(t, "2024-01", result.Groups[0].Key)
+	assert.Len(t, report.Currencies, 1)
+	assert.Equal(t, aggregateResult.Count, report.Currencies[0].Count)
+	assert.Len(t, report.Currencies[0].Groups, 2)
+	assert.Equal(t, "2024-01", report.Currencies[0].Groups[0].Key)
+}
+
+func TestAnalyticsService_GetAnalytics_SplitsByCurrency(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From:    analyticsFrom,
+		To:      analyticsTo,
+		GroupBy: domain.GroupByCategory,
+	}
+
+	rub := newTestAnalyticsResult()
+	usd := domain.AnalyticsResult{Currency: "USD", TotalSum: decimal.NewFromInt(235), Count: 2}
+	groups := []domain.GroupedAnalytics{
+		{Key: "investments", Currency: "USD", TotalSum: decimal.NewFromInt(200), Count: 1},
+		{Key: "salary", Currency: "RUB", TotalSum: decimal.NewFromInt(1000), Count: 10},
+		{Key: "subscriptions", Currency: "USD", TotalSum: decimal.NewFromInt(35), Count: 1},
+	}
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{rub, usd}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return(groups, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Len(t, report.Currencies, 2)
+	assert.Len(t, report.Currencies[0].Groups, 1)
+	assert.Equal(t, "salary", report.Currencies[0].Groups[0].Key)
+	assert.Len(t, report.Currencies[1].Groups, 2)
+	assert.Equal(t, "investments", report.Currencies[1].Groups[0].Key)
+	assert.Equal(t, "subscriptions", report.Currencies[1].Groups[1].Key)
+}
+
+func TestAnalyticsService_GetAnalytics_BaseCurrency(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo, BaseCurrency: "RUB"}
+	unconverted := []domain.UnconvertedItem{
+		{ItemID: "id-1", Currency: "USD", Amount: decimal.NewFromInt(35), Date: analyticsFrom},
+	}
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{newTestAnalyticsResult()}, nil)
+	repo.EXPECT().FindUnconverted(mock.Anything, filter).Return(unconverted, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Equal(t, "RUB", report.BaseCurrency)
+	assert.Len(t, report.Currencies, 1)
+	assert.Len(t, report.Unconverted, 1)
+	assert.Equal(t, "id-1", report.Unconverted[0].ItemID)
+}
+
+func TestAnalyticsService_GetAnalytics_InvalidBaseCurrency(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo, BaseCurrency: "rub"}
+
+	_, err := svc.GetAnalytics(context.Background(), filter)
+	assert.ErrorIs(t, err, domain.ErrInvalidCurrency)
 }
 
 func TestAnalyticsService_GetAnalytics_InvalidFilter(t *testing.T) {
@@ -86,7 +151,7 @@ func TestAnalyticsService_GetAnalytics_AggregateError(t *testing.T) {
 	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo}
 	dbErr := errors.New("database error")
 
-	repo.EXPECT().Aggregate(mock.Anything, analyticsFrom, analyticsTo, "").Return(domain.AnalyticsResult{}, dbErr)
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return(nil, dbErr)
 
 	_, err := svc.GetAnalytics(context.Background(), filter)
 	assert.ErrorIs(t, err, dbErr)
@@ -103,9 +168,372 @@ func TestAnalyticsService_GetAnalytics_GroupedError(t *testing.T) {
 	}
 	dbErr := errors.New("grouped query failed")
 
-	repo.EXPECT().Aggregate(mock.Anything, analyticsFrom, analyticsTo, "").Return(newTestAnalyticsResult(), nil)
-	repo.EXPECT().AggregateGrouped(mock.Anything, analyticsFrom, analyticsTo, domain.GroupByDay, "").Return(nil, dbErr)
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{newTestAnalyticsResult()}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return(nil, dbErr)
 
 	_, err := svc.GetAnalytics(context.Background(), filter)
 	assert.ErrorIs(t, err, dbErr)
 }
+
+func TestAnalyticsService_GetAnalytics_CategoryTree(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From:    analyticsFrom,
+		To:      analyticsTo,
+		GroupBy: domain.GroupByCategoryTree,
+	}
+
+	transport, metro := "transport-id", "metro-id"
+	nodes := []domain.CategoryTreeGroup{
+		{CategoryID: "food-id", GroupedAnalytics: domain.GroupedAnalytics{Key: "food", Currency: "RUB", Count: 2}},
+		{CategoryID: metro, ParentID: &transport, GroupedAnalytics: domain.GroupedAnalytics{Key: "metro", Currency: "RUB", Count: 3}},
+		{CategoryID: "night-id", ParentID: &metro, GroupedAnalytics: domain.GroupedAnalytics{Key: "night", Currency: "RUB", Count: 1}},
+		{CategoryID: "taxi-id", ParentID: &transport, GroupedAnalytics: domain.GroupedAnalytics{Key: "taxi", Currency: "RUB", Count: 1}},
+		{CategoryID: transport, GroupedAnalytics: domain.GroupedAnalytics{Key: "transport", Currency: "RUB", Count: 4}},
+		// В USD есть только такси: без родителя в той же валюте оно становится корнем.
+		{CategoryID: "taxi-id", ParentID: &transport, GroupedAnalytics: domain.GroupedAnalytics{Key: "taxi", Currency: "USD", Count: 1}},
+	}
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{
+		{Currency: "RUB", Count: 6}, {Currency: "USD", Count: 1},
+	}, nil)
+	repo.EXPECT().AggregateCategoryTree(mock.Anything, filter).Return(nodes, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	assert.NoError(t, err)
+
+	rub := report.Currencies[0].Groups
+	assert.Len(t, rub, 2)
+	assert.Equal(t, "food", rub[0].Key)
+	assert.Empty(t, rub[0].Children)
+	assert.Equal(t, "transport", rub[1].Key)
+	assert.Equal(t, int64(4), rub[1].Count)
+	assert.Len(t, rub[1].Children, 2)
+	assert.Equal(t, "metro", rub[1].Children[0].Key)
+	assert.Equal(t, "night", rub[1].Children[0].Children[0].Key)
+	assert.Equal(t, "taxi", rub[1].Children[1].Key)
+
+	usd := report.Currencies[1].Groups
+	assert.Len(t, usd, 1)
+	assert.Equal(t, "taxi", usd[0].Key)
+}
+
+func TestAnalyticsService_GetAnalytics_GroupByTag(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From:    analyticsFrom,
+		To:      analyticsTo,
+		GroupBy: domain.GroupByTag,
+	}
+
+	groups := []domain.GroupedAnalytics{
+		{Key: "reimbursable", Currency: "RUB", TotalSum: decimal.NewFromInt(300), Count: 2},
+		{Key: "vacation-2026", Currency: "RUB", TotalSum: decimal.NewFromInt(250), Count: 1},
+	}
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{newTestAnalyticsResult()}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return(groups, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, report.Currencies, 1)
+	assert.Equal(t, groups, report.Currencies[0].Groups)
+}
+
+func TestAnalyticsService_GetAnalytics_ComparePreviousPeriod(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		GroupBy: domain.GroupByMonth,
+		Compare: domain.ComparePreviousPeriod,
+	}
+	// целый месяц сравнивается с предыдущим месяцем, а не с 31 днём перед ним
+	prev := filter
+	prev.Compare = domain.CompareNone
+	prev.From = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	prev.To = time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{
+		{Currency: "RUB", TotalSum: decimal.NewFromInt(1500), Count: 3},
+	}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return([]domain.GroupedAnalytics{
+		{Key: "2024-03-01", Currency: "RUB", TotalSum: decimal.NewFromInt(1500), Count: 3},
+	}, nil)
+	repo.EXPECT().Aggregate(mock.Anything, prev).Return([]domain.AnalyticsResult{
+		{Currency: "RUB", TotalSum: decimal.NewFromInt(1000), Count: 4},
+	}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, prev).Return([]domain.GroupedAnalytics{
+		{Key: "2024-02-01", Currency: "RUB", TotalSum: decimal.NewFromInt(1000), Count: 4},
+	}, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	require.NoError(t, err)
+
+	require.NotNil(t, report.Comparison)
+	assert.Equal(t, prev.From, report.Comparison.From)
+	assert.Equal(t, prev.To, report.Comparison.To)
+	assert.Len(t, report.Comparison.Currencies, 1)
+
+	delta := report.Currencies[0].Delta
+	require.NotNil(t, delta)
+	assert.Equal(t, "500", delta.TotalSum.String())
+	assert.Equal(t, "50", delta.TotalSumPercent.String())
+	assert.Equal(t, int64(-1), delta.Count)
+	assert.Equal(t, "-25", delta.CountPercent.String())
+
+	groupDelta := report.Currencies[0].Groups[0].Delta
+	require.NotNil(t, groupDelta)
+	assert.Equal(t, "500", groupDelta.TotalSum.String())
+}
+
+func TestAnalyticsService_GetAnalytics_ComparePreviousYear(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From:    time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		GroupBy: domain.GroupByCategory,
+		Compare: domain.ComparePreviousYear,
+	}
+	prev := filter
+	prev.Compare = domain.CompareNone
+	prev.From = time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC)
+	prev.To = time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{
+		{Currency: "RUB", TotalSum: decimal.NewFromInt(300), Count: 2},
+	}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return([]domain.GroupedAnalytics{
+		{Key: "Food", Currency: "RUB", TotalSum: decimal.NewFromInt(200), Count: 1},
+		{Key: "Travel", Currency: "RUB", TotalSum: decimal.NewFromInt(100), Count: 1},
+	}, nil)
+	repo.EXPECT().Aggregate(mock.Anything, prev).Return([]domain.AnalyticsResult{
+		{Currency: "RUB", TotalSum: decimal.NewFromInt(300), Count: 3},
+	}, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, prev).Return([]domain.GroupedAnalytics{
+		{Key: "Food", Currency: "RUB", TotalSum: decimal.NewFromInt(300), Count: 3},
+	}, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	require.NoError(t, err)
+
+	groups := report.Currencies[0].Groups
+	require.Len(t, groups, 2)
+	assert.Equal(t, "-100", groups[0].Delta.TotalSum.String())
+	assert.Equal(t, "-33.33", groups[0].Delta.TotalSumPercent.String())
+	// новой категории не с чем сравнивать
+	assert.Equal(t, "100", groups[1].Delta.TotalSum.String())
+	assert.Nil(t, groups[1].Delta.TotalSumPercent)
+}
+
+func TestAnalyticsService_GetAnalytics_InvalidCompare(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo, Compare: "last_week"}
+
+	_, err := svc.GetAnalytics(context.Background(), filter)
+	assert.ErrorIs(t, err, domain.ErrInvalidCompare)
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestAnalyticsService_GetCashflow(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo}
+	monthly := filter
+	monthly.GroupBy = domain.GroupByMonth
+
+	repo.EXPECT().Cashflow(mock.Anything, monthly).Return([]domain.CashflowBucket{
+		{Key: "2024-01-01", Currency: "EUR", Income: decimal.NewFromInt(100), Net: decimal.NewFromInt(100),
+			CumulativeNet: decimal.NewFromInt(100)},
+		{Key: "2024-01-01", Currency: "RUB", Income: decimal.NewFromInt(1000), Expense: decimal.NewFromInt(400),
+			Net: decimal.NewFromInt(600), CumulativeNet: decimal.NewFromInt(600)},
+		{Key: "2024-02-01", Currency: "RUB", Expense: decimal.NewFromInt(800),
+			Net: decimal.NewFromInt(-800), CumulativeNet: decimal.NewFromInt(-200)},
+	}, nil)
+
+	report, err := svc.GetCashflow(context.Background(), filter)
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.GroupByMonth, report.GroupBy)
+	require.Len(t, report.Currencies, 2)
+	assert.Equal(t, "EUR", report.Currencies[0].Currency)
+	assert.Len(t, report.Currencies[0].Buckets, 1)
+
+	rub := report.Currencies[1]
+	assert.Equal(t, "1000", rub.Income.String())
+	assert.Equal(t, "1200", rub.Expense.String())
+	assert.Equal(t, "-200", rub.Net.String())
+	require.Len(t, rub.Buckets, 2)
+	assert.Equal(t, "-200", rub.Buckets[1].CumulativeNet.String())
+}
+
+func TestAnalyticsService_GetCashflow_InvalidFilter(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	_, err := svc.GetCashflow(context.Background(), domain.AnalyticsFilter{
+		From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByCategory,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidCashflowGroupBy)
+
+	_, err = svc.GetCashflow(context.Background(), domain.AnalyticsFilter{
+		From: analyticsFrom, To: analyticsTo, Type: domain.TypeIncome,
+	})
+	assert.ErrorIs(t, err, domain.ErrCashflowFilter)
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestAnalyticsService_GetAnalytics_InvalidWeekStartAndTimezone(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	_, err := svc.GetAnalytics(context.Background(), domain.AnalyticsFilter{
+		From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByWeek, WeekStart: "sun",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidWeekStart)
+
+	_, err = svc.GetAnalytics(context.Background(), domain.AnalyticsFilter{
+		From: analyticsFrom, To: analyticsTo, Timezone: "Mars/Olympus_Mons",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidTimezone)
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestGroupMatchKey(t *testing.T) {
+	// 2024-03-06 — среда; недели с воскресенья начинаются 03.03, 10.03, ...
+	from := time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC)
+	prevFrom := time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC) // понедельник, его неделя — с 05.03
+
+	weekly := domain.AnalyticsFilter{GroupBy: domain.GroupByWeek, WeekStart: "sunday"}
+	assert.Equal(t, "0", groupMatchKey(weekly, from)("2024-03-03"))
+	assert.Equal(t, "1", groupMatchKey(weekly, from)("2024-03-10"))
+	assert.Equal(t, "0", groupMatchKey(weekly, prevFrom)("2023-03-05"))
+
+	quarterly := domain.AnalyticsFilter{GroupBy: domain.GroupByQuarter}
+	assert.Equal(t, "0", groupMatchKey(quarterly, from)("2024-01-01"))
+	assert.Equal(t, "2", groupMatchKey(quarterly, from)("2024-07-01"))
+
+	yearly := domain.AnalyticsFilter{GroupBy: domain.GroupByYear}
+	assert.Equal(t, "1", groupMatchKey(yearly, prevFrom)("2024-01-01"))
+
+	byCategory := domain.AnalyticsFilter{GroupBy: domain.GroupByCategory}
+	assert.Equal(t, "Food", groupMatchKey(byCategory, from)("Food"))
+}
+
+func TestAnalyticsService_GetAnalytics_InvalidFill(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	for _, f := range []domain.AnalyticsFilter{
+		{From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByDay, Fill: "null"},
+		{From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByCategory, Fill: domain.FillZero},
+		{From: analyticsFrom, To: analyticsTo, Fill: domain.FillZero},
+	} {
+		_, err := svc.GetAnalytics(context.Background(), f)
+		assert.ErrorIs(t, err, domain.ErrInvalidFill)
+		assert.True(t, domain.IsValidationError(err))
+	}
+}
+
+func TestAnalyticsService_GetAnalytics_FillZeroEmptyPeriod(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From: analyticsFrom, To: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		GroupBy: domain.GroupByMonth, Fill: domain.FillZero,
+	}
+
+	// за период нет записей: итогов нет, но репозиторий отдаёт нулевой ряд
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return(nil, nil)
+	repo.EXPECT().AggregateGrouped(mock.Anything, filter).Return([]domain.GroupedAnalytics{
+		{Key: "2024-01-01", Currency: "RUB"},
+		{Key: "2024-02-01", Currency: "RUB"},
+	}, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, report.Currencies, 1)
+	assert.Equal(t, "RUB", report.Currencies[0].Currency)
+	assert.Equal(t, int64(0), report.Currencies[0].Count)
+	assert.Len(t, report.Currencies[0].Groups, 2)
+}
+
+func TestAnalyticsService_GetCashflow_FillZero(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{From: analyticsFrom, To: analyticsTo, Fill: domain.FillZero}
+	monthly := filter
+	monthly.GroupBy = domain.GroupByMonth
+
+	repo.EXPECT().Cashflow(mock.Anything, monthly).Return(nil, nil)
+
+	report, err := svc.GetCashflow(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Empty(t, report.Currencies)
+}
+
+func TestAnalyticsService_GetAnalytics_Pivot(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	filter := domain.AnalyticsFilter{
+		From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByMonth, PivotBy: domain.GroupByCategory,
+	}
+
+	repo.EXPECT().Aggregate(mock.Anything, filter).Return([]domain.AnalyticsResult{newTestAnalyticsResult()}, nil)
+	repo.EXPECT().AggregatePivot(mock.Anything, filter).Return([]domain.PivotCell{
+		{RowKey: "2024-01-01", ColumnKey: "Food", Currency: "RUB", TotalSum: decimal.NewFromInt(300), Count: 2},
+		{RowKey: "2024-01-01", ColumnKey: "Travel", Currency: "RUB", TotalSum: decimal.NewFromInt(500), Count: 1},
+		{RowKey: "2024-02-01", ColumnKey: "Food", Currency: "RUB", TotalSum: decimal.NewFromInt(200), Count: 1},
+	}, nil)
+
+	report, err := svc.GetAnalytics(context.Background(), filter)
+	require.NoError(t, err)
+
+	pivot := report.Currencies[0].Pivot
+	require.NotNil(t, pivot)
+	assert.Nil(t, report.Currencies[0].Groups)
+	assert.Equal(t, domain.GroupByMonth, pivot.RowsBy)
+	assert.Equal(t, domain.GroupByCategory, pivot.ColumnsBy)
+	assert.Equal(t, []string{"2024-01-01", "2024-02-01"}, pivot.Rows)
+	assert.Equal(t, []string{"Food", "Travel"}, pivot.Columns)
+	require.Len(t, pivot.Cells, 2)
+	assert.Equal(t, "500", pivot.Cells[0][1].TotalSum.String())
+	assert.Equal(t, "200", pivot.Cells[1][0].TotalSum.String())
+	// в феврале поездок не было — ячейка нулевая
+	assert.Equal(t, int64(0), pivot.Cells[1][1].Count)
+	assert.True(t, pivot.Cells[1][1].TotalSum.IsZero())
+}
+
+func TestAnalyticsService_GetAnalytics_InvalidPivot(t *testing.T) {
+	repo := newMockanalyticsRepository(t)
+	svc := NewAnalyticsService(repo)
+
+	for _, f := range []domain.AnalyticsFilter{
+		{From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByCategory, PivotBy: domain.GroupByType},
+		{From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByMonth, PivotBy: domain.GroupByTag},
+	} {
+		_, err := svc.GetAnalytics(context.Background(), f)
+		assert.ErrorIs(t, err, domain.ErrInvalidPivot)
+	}
+
+	_, err := svc.GetAnalytics(context.Background(), domain.AnalyticsFilter{
+		From: analyticsFrom, To: analyticsTo, GroupBy: domain.GroupByMonth, PivotBy: domain.GroupByType,
+		Compare: domain.ComparePreviousYear,
+	})
+	assert.ErrorIs(t, err, domain.ErrPivotCompare)
+	assert.True(t, domain.IsValidationError(err))
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/helpers"
+)
+
+type attachmentRepository interface {
+	Create(ctx context.Context, a domain.Attachment) (domain.Attachment, error)
+	List(ctx context.Context, itemID string) ([]domain.Attachment, error)
+	GetByID(ctx context.Context, itemID, id string) (domain.Attachment, error)
+	Delete(ctx context.Context, itemID, id string) (domain.Attachment, error)
+}
+
+// blobStorage — хранилище содержимого вложений (локальный диск, S3 и т.п.).
+type blobStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// sniffLen — сколько первых байт файла нужно http.DetectContentType.
+const sniffLen = 512
+
+const maxFileNameLen = 255
+
+type AttachmentService struct {
+	repo    attachmentRepository
+	blobs   blobStorage
+	maxSize int64
+}
+
+func NewAttachmentService(repo attachmentRepository, blobs blobStorage, maxSize int64) *AttachmentService {
+	return &AttachmentService{
+		repo:    repo,
+		blobs:   blobs,
+		maxSize: maxSize,
+	}
+}
+
+// Upload сохраняет файл size байт в хранилище и прикладывает его к записи itemID.
+// Тип файла определяется по содержимому, а не по имени или заголовку клиента.
+func (s *AttachmentService) Upload(
+	ctx context.Context, itemID, fileName string, size int64, content io.Reader,
+) (domain.Attachment, error) {
+	if err := helpers.ParseUUID(itemID); err != nil {
+		return domain.Attachment{}, domain.ErrInvalidID
+	}
+	if size <= 0 {
+		return domain.Attachment{}, domain.ErrEmptyAttachment
+	}
+	if size > s.maxSize {
+		return domain.Attachment{}, fmt.Errorf("%w: limit is %d bytes", domain.ErrAttachmentTooLarge, s.maxSize)
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return domain.Attachment{}, fmt.Errorf("read attachment: %w", err)
+	}
+	head = head[:n]
+
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || !domain.IsAttachmentType(mimeType) {
+		return domain.Attachment{}, domain.ErrAttachmentType
+	}
+
+	id := uuid.NewString()
+	a := domain.Attachment{
+		ID:         id,
+		ItemID:     itemID,
+		FileName:   cleanFileName(fileName),
+		MimeType:   mimeType,
+		Size:       size,
+		StorageKey: itemID + "/" + id,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	body := io.MultiReader(bytes.NewReader(head), io.LimitReader(content, size-int64(n)))
+	if err = s.blobs.Put(ctx, a.StorageKey, body); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	created, err := s.repo.Create(ctx, a)
+	if err != nil {
+		// метаданные не сохранились — файл больше никому не нужен
+		_ = s.blobs.Delete(ctx, a.StorageKey)
+		return domain.Attachment{}, err
+	}
+	return created, nil
+}
+
+func (s *AttachmentService) List(ctx context.Context, itemID string) ([]domain.Attachment, error) {
+	if err := helpers.ParseUUID(itemID); err != nil {
+		return nil, domain.ErrInvalidID
+	}
+
+	attachments, err := s.repo.List(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if attachments == nil {
+		attachments = []domain.Attachment{}
+	}
+	return attachments, nil
+}
+
+// Open возвращает вложение и его содержимое; закрыть reader должен вызывающий.
+func (s *AttachmentService) Open(ctx context.Context, itemID, id string) (domain.Attachment, io.ReadCloser, error) {
+	if err := parseAttachmentIDs(itemID, id); err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	a, err := s.repo.GetByID(ctx, itemID, id)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+	content, err := s.blobs.Open(ctx, a.StorageKey)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+	return a, content, nil
+}
+
+// Delete удаляет вложение. Если метаданные удалены, а файл удалить не удалось,
+// возвращается ErrAttachmentCleanup: вложения уже нет, остался только файл.
+func (s *AttachmentService) Delete(ctx context.Context, itemID, id string) error {
+	if err := parseAttachmentIDs(itemID, id); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.Delete(ctx, itemID, id)
+	if err != nil {
+		return err
+	}
+	return deleteBlobs(ctx, s.blobs, []string{deleted.StorageKey})
+}
+
+func parseAttachmentIDs(itemID, id string) error {
+	if err := helpers.ParseUUID(itemID); err != nil {
+		return domain.ErrInvalidID
+	}
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.ErrInvalidID
+	}
+	return nil
+}
+
+// deleteBlobs удаляет файлы по всем ключам, не останавливаясь на ошибках; ошибки собираются
+// под ErrAttachmentCleanup.
+func deleteBlobs(ctx context.Context, blobs blobRemover, keys []string) error {
+	var errs []error
+	for _, key := range keys {
+		if err := blobs.Delete(ctx, key); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", domain.ErrAttachmentCleanup, errors.Join(errs...))
+	}
+	return nil
+}
+
+// cleanFileName оставляет от имени файла клиента только последнюю часть пути без управляющих
+// символов и обрезает её до maxFileNameLen байт.
+func cleanFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+
+	for len(name) > maxFileNameLen {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
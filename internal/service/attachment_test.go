@@ -0,0 +1,103 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testPDF = []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\n")
+
+func TestAttachmentService_Upload_Success(t *testing.T) {
+	repo := newMockattachmentRepository(t)
+	blobs := newMockblobStorage(t)
+	svc := NewAttachmentService(repo, blobs, 1024)
+
+	var stored []byte
+	blobs.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, key string, r io.Reader) error {
+			assert.True(t, strings.HasPrefix(key, validUUID+"/"))
+			var err error
+			stored, err = io.ReadAll(r)
+			return err
+		})
+	repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a domain.Attachment) bool {
+		return a.ItemID == validUUID && a.MimeType == "application/pdf" &&
+			a.FileName == "receipt.pdf" && a.Size == int64(len(testPDF))
+	})).RunAndReturn(func(_ context.Context, a domain.Attachment) (domain.Attachment, error) {
+		return a, nil
+	})
+
+	created, err := svc.Upload(context.Background(), validUUID, `C:\scans\receipt.pdf`,
+		int64(len(testPDF)), bytes.NewReader(testPDF))
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", created.MimeType)
+	assert.Equal(t, testPDF, stored)
+}
+
+func TestAttachmentService_Upload_Rejected(t *testing.T) {
+	svc := NewAttachmentService(newMockattachmentRepository(t), newMockblobStorage(t), 16)
+
+	_, err := svc.Upload(context.Background(), validUUID, "a.txt", 5, strings.NewReader("hello"))
+	assert.ErrorIs(t, err, domain.ErrAttachmentType)
+
+	_, err = svc.Upload(context.Background(), validUUID, "big.pdf", 17, bytes.NewReader(testPDF))
+	assert.ErrorIs(t, err, domain.ErrAttachmentTooLarge)
+
+	_, err = svc.Upload(context.Background(), validUUID, "empty.pdf", 0, bytes.NewReader(nil))
+	assert.ErrorIs(t, err, domain.ErrEmptyAttachment)
+
+	_, err = svc.Upload(context.Background(), "bad", "a.pdf", 5, bytes.NewReader(testPDF))
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestAttachmentService_Upload_ItemNotFoundRemovesBlob(t *testing.T) {
+	repo := newMockattachmentRepository(t)
+	blobs := newMockblobStorage(t)
+	svc := NewAttachmentService(repo, blobs, 1024)
+
+	var key string
+	blobs.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, k string, _ io.Reader) error {
+			key = k
+			return nil
+		})
+	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(domain.Attachment{}, domain.ErrItemNotFound)
+	blobs.EXPECT().Delete(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, k string) error {
+			assert.Equal(t, key, k)
+			return nil
+		})
+
+	_, err := svc.Upload(context.Background(), validUUID, "a.pdf", int64(len(testPDF)), bytes.NewReader(testPDF))
+	assert.ErrorIs(t, err, domain.ErrItemNotFound)
+}
+
+func TestAttachmentService_Delete_CleanupError(t *testing.T) {
+	repo := newMockattachmentRepository(t)
+	blobs := newMockblobStorage(t)
+	svc := NewAttachmentService(repo, blobs, 1024)
+
+	repo.EXPECT().Delete(mock.Anything, validUUID, validUUID).
+		Return(domain.Attachment{StorageKey: "k"}, nil)
+	blobs.EXPECT().Delete(mock.Anything, "k").Return(errors.New("disk error"))
+
+	err := svc.Delete(context.Background(), validUUID, validUUID)
+	assert.ErrorIs(t, err, domain.ErrAttachmentCleanup)
+}
+
+func TestCleanFileName(t *testing.T) {
+	assert.Equal(t, "receipt.jpg", cleanFileName("../../receipt.jpg"))
+	assert.Equal(t, "scan.pdf", cleanFileName(`C:\Users\me\scan.pdf`))
+	assert.Equal(t, "attachment", cleanFileName("  "))
+	assert.Equal(t, "ab.png", cleanFileName("a\nb.png"))
+	assert.Len(t, cleanFileName(strings.Repeat("я", 200)), 254)
+}
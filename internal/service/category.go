@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/helpers"
+)
+
+type categoryRepository interface {
+	Create(ctx context.Context, cat domain.Category) (domain.Category, error)
+	GetByID(ctx context.Context, id string) (domain.Category, error)
+	GetAll(ctx context.Context, includeArchived bool) ([]domain.Category, error)
+	Update(ctx context.Context, cat domain.Category) (domain.Category, error)
+	Delete(ctx context.Context, id string) error
+	InUse(ctx context.Context, name, otherThanType string) (bool, error)
+}
+
+type CategoryService struct {
+	repo categoryRepository
+}
+
+func NewCategoryService(repo categoryRepository) *CategoryService {
+	return &CategoryService{repo: repo}
+}
+
+func (s *CategoryService) Create(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	if cat.ParentID != nil {
+		if err := s.checkParent(ctx, *cat.ParentID); err != nil {
+			return domain.Category{}, err
+		}
+	}
+
+	created, err := s.repo.Create(ctx, cat)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return created, nil
+}
+
+func (s *CategoryService) List(ctx context.Context, includeArchived bool) ([]domain.Category, error) {
+	cats, err := s.repo.GetAll(ctx, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	return cats, nil
+}
+
+func (s *CategoryService) GetByID(ctx context.Context, id string) (domain.Category, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.Category{}, domain.ErrInvalidID
+	}
+
+	cat, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return cat, nil
+}
+
+// Update меняет категорию. Записи ссылаются на категорию по имени, поэтому используемую
+// категорию нельзя переименовать или ограничить типом, которому не соответствуют её записи.
+func (s *CategoryService) Update(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	old, err := s.GetByID(ctx, cat.ID)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	if cat.ParentID != nil {
+		if err = s.checkParent(ctx, *cat.ParentID); err != nil {
+			return domain.Category{}, err
+		}
+		if err = s.checkCycle(ctx, cat.ID, *cat.ParentID); err != nil {
+			return domain.Category{}, err
+		}
+	}
+
+	if cat.Name != old.Name {
+		if err = s.checkUnused(ctx, old.Name, ""); err != nil {
+			return domain.Category{}, err
+		}
+	}
+	if cat.Type != "" && cat.Type != old.Type {
+		if err = s.checkUnused(ctx, old.Name, cat.Type); err != nil {
+			return domain.Category{}, err
+		}
+	}
+
+	updated, err := s.repo.Update(ctx, cat)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return updated, nil
+}
+
+// Delete удаляет категорию, если на неё не ссылаются записи, шаблоны и подкатегории.
+func (s *CategoryService) Delete(ctx context.Context, id string) error {
+	cat, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = s.checkUnused(ctx, cat.Name, ""); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *CategoryService) checkParent(ctx context.Context, parentID string) error {
+	if err := helpers.ParseUUID(parentID); err != nil {
+		return domain.ErrParentCategory
+	}
+	if _, err := s.repo.GetByID(ctx, parentID); err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			return domain.ErrParentCategory
+		}
+		return err
+	}
+	return nil
+}
+
+// checkCycle проверяет, что parentID не сама категория id и не её потомок.
+func (s *CategoryService) checkCycle(ctx context.Context, id, parentID string) error {
+	cats, err := s.repo.GetAll(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	parents := make(map[string]*string, len(cats))
+	for _, c := range cats {
+		parents[c.ID] = c.ParentID
+	}
+
+	// Число шагов ограничено на случай уже испорченной иерархии.
+	for cur, steps := &parentID, 0; cur != nil && steps <= len(cats); cur, steps = parents[*cur], steps+1 {
+		if *cur == id {
+			return domain.ErrCategoryCycle
+		}
+	}
+	return nil
+}
+
+func (s *CategoryService) checkUnused(ctx context.Context, name, otherThanType string) error {
+	used, err := s.repo.InUse(ctx, name, otherThanType)
+	if err != nil {
+		return err
+	}
+	if used {
+		return domain.ErrCategoryInUse
+	}
+	return nil
+}
+
+// resolveCategory проверяет, что запись типа itemType можно отнести к категории cat,
+// и возвращает каноническое имя категории. Архивная категория допустима только для
+// уже существующих записей (allowArchived).
+func resolveCategory(cat domain.Category, itemType string, allowArchived bool) (string, error) {
+	if cat.Archived && !allowArchived {
+		return "", fmt.Errorf("%w: %q", domain.ErrCategoryArchived, cat.Name)
+	}
+	if !cat.Allows(itemType) {
+		return "", fmt.Errorf("%w: %q accepts only %s", domain.ErrCategoryType, cat.Name, cat.Type)
+	}
+	return cat.Name, nil
+}
+
+// lookupCategory находит категорию по имени без учёта регистра и проверяет её через resolveCategory.
+func lookupCategory(
+	ctx context.Context, categories categoryLookup, name, itemType string, allowArchived bool,
+) (string, error) {
+	cat, err := categories.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			return "", fmt.Errorf("%w: %q", domain.ErrUnknownCategory, name)
+		}
+		return "", err
+	}
+	return resolveCategory(cat, itemType, allowArchived)
+}
+
+// categorySet — все категории, загруженные одним запросом для проверки пачки записей.
+type categorySet struct {
+	exact map[string]domain.Category
+	lower map[string]domain.Category
+}
+
+func loadCategorySet(ctx context.Context, categories categoryLookup) (categorySet, error) {
+	cats, err := categories.GetAll(ctx, true)
+	if err != nil {
+		return categorySet{}, err
+	}
+
+	set := categorySet{
+		exact: make(map[string]domain.Category, len(cats)),
+		lower: make(map[string]domain.Category, len(cats)),
+	}
+	for _, c := range cats {
+		set.exact[c.Name] = c
+		if _, ok := set.lower[strings.ToLower(c.Name)]; !ok {
+			set.lower[strings.ToLower(c.Name)] = c
+		}
+	}
+	return set, nil
+}
+
+func (s categorySet) resolve(name, itemType string, allowArchived bool) (string, error) {
+	cat, ok := s.exact[name]
+	if !ok {
+		if cat, ok = s.lower[strings.ToLower(name)]; !ok {
+			return "", fmt.Errorf("%w: %q", domain.ErrUnknownCategory, name)
+		}
+	}
+	return resolveCategory(cat, itemType, allowArchived)
+}
+
+// resolveSplits проверяет разбивку записи item по категориям набора.
+func (s categorySet) resolveSplits(item domain.Item, allowArchived bool) ([]domain.ItemSplit, error) {
+	return resolveSplits(item.Amount, item.Splits, func(name string) (string, error) {
+		return s.resolve(name, item.Type, allowArchived)
+	})
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	parentUUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	childUUID  = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+)
+
+func newTestCategory() domain.Category {
+	return domain.Category{ID: validUUID, Name: "food", Type: domain.TypeExpense}
+}
+
+func TestCategoryService_Create_Success(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	parentID := parentUUID
+	input := newTestCategory()
+	input.ID = ""
+	input.ParentID = &parentID
+
+	repo.EXPECT().GetByID(mock.Anything, parentUUID).Return(domain.Category{ID: parentUUID, Name: "home"}, nil)
+	repo.EXPECT().Create(mock.Anything, input).Return(newTestCategory(), nil)
+
+	result, err := svc.Create(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, validUUID, result.ID)
+}
+
+func TestCategoryService_Create_ParentNotFound(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	parentID := parentUUID
+	input := newTestCategory()
+	input.ParentID = &parentID
+
+	repo.EXPECT().GetByID(mock.Anything, parentUUID).Return(domain.Category{}, domain.ErrCategoryNotFound)
+
+	_, err := svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrParentCategory)
+}
+
+func TestCategoryService_Update_RenameInUse(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	input := newTestCategory()
+	input.Name = "groceries"
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestCategory(), nil)
+	repo.EXPECT().InUse(mock.Anything, "food", "").Return(true, nil)
+
+	_, err := svc.Update(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrCategoryInUse)
+}
+
+func TestCategoryService_Update_TypeConflictsWithItems(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	old := newTestCategory()
+	old.Type = ""
+	input := newTestCategory()
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(old, nil)
+	repo.EXPECT().InUse(mock.Anything, "food", domain.TypeExpense).Return(true, nil)
+
+	_, err := svc.Update(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrCategoryInUse)
+}
+
+func TestCategoryService_Update_Cycle(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	// food → child; перенос food под child замкнул бы цикл.
+	foodID := validUUID
+	childID := childUUID
+	input := newTestCategory()
+	input.ParentID = &childID
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestCategory(), nil)
+	repo.EXPECT().GetByID(mock.Anything, childUUID).Return(domain.Category{ID: childUUID, ParentID: &foodID}, nil)
+	repo.EXPECT().GetAll(mock.Anything, true).Return([]domain.Category{
+		newTestCategory(),
+		{ID: childUUID, Name: "snacks", ParentID: &foodID},
+	}, nil)
+
+	_, err := svc.Update(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+}
+
+func TestCategoryService_Delete_InUse(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestCategory(), nil)
+	repo.EXPECT().InUse(mock.Anything, "food", "").Return(true, nil)
+
+	err := svc.Delete(context.Background(), validUUID)
+	assert.ErrorIs(t, err, domain.ErrCategoryInUse)
+}
+
+func TestCategoryService_Delete_InvalidUUID(t *testing.T) {
+	repo := newMockcategoryRepository(t)
+	svc := NewCategoryService(repo)
+
+	err := svc.Delete(context.Background(), "bad-id")
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
@@ -3,28 +3,71 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	"github.com/wb-go/wbf/helpers"
 )
 
 type itemRepository interface {
 	Create(ctx context.Context, item domain.Item) (domain.Item, error)
-	GetAll(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error)
+	GetAll(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error)
 	GetByID(ctx context.Context, id string) (domain.Item, error)
 	Update(ctx context.Context, item domain.Item) (domain.Item, error)
+	Patch(ctx context.Context, patch domain.ItemPatch) (domain.Item, error)
 	Delete(ctx context.Context, id string) error
+	ApplyBatch(ctx context.Context, ops []domain.BatchOperation) ([]domain.BatchResult, error)
+	Restore(ctx context.Context, id string) (domain.Item, error)
+	Purge(ctx context.Context, olderThan time.Time) (int64, []string, error)
+	GetHistory(ctx context.Context, itemID string) ([]domain.ItemHistoryEntry, error)
+	Exists(ctx context.Context, id string) (bool, error)
+}
+
+// categoryLookup — справочник категорий, по которому проверяются записи.
+type categoryLookup interface {
+	GetByName(ctx context.Context, name string) (domain.Category, error)
+	GetAll(ctx context.Context, includeArchived bool) ([]domain.Category, error)
+}
+
+// blobRemover — хранилище файлов вложений, из которого удаляются файлы окончательно удалённых записей.
+type blobRemover interface {
+	Delete(ctx context.Context, key string) error
 }
 
 type ItemService struct {
-	repo itemRepository
+	repo       itemRepository
+	categories categoryLookup
+	blobs      blobRemover
 }
 
-func NewItemService(repo itemRepository) *ItemService {
-	return &ItemService{repo: repo}
+func NewItemService(repo itemRepository, categories categoryLookup, blobs blobRemover) *ItemService {
+	return &ItemService{
+		repo:       repo,
+		categories: categories,
+		blobs:      blobs,
+	}
 }
 
 func (s *ItemService) Create(ctx context.Context, item domain.Item) (domain.Item, error) {
+	return s.create(ctx, item, false)
+}
+
+// CreateScheduled создаёт запись по шаблону повторяющейся операции. В отличие от Create
+// допускает архивные категории: архивирование не останавливает уже настроенные серии.
+func (s *ItemService) CreateScheduled(ctx context.Context, item domain.Item) (domain.Item, error) {
+	return s.create(ctx, item, true)
+}
+
+func (s *ItemService) create(ctx context.Context, item domain.Item, allowArchived bool) (domain.Item, error) {
+	var err error
+	if item.Category, err = lookupCategory(ctx, s.categories, item.Category, item.Type, allowArchived); err != nil {
+		return domain.Item{}, err
+	}
+	if item.Splits, err = s.lookupSplits(ctx, item.Amount, item.Splits, item.Type, allowArchived); err != nil {
+		return domain.Item{}, err
+	}
+
 	created, err := s.repo.Create(ctx, item)
 	if err != nil {
 		return domain.Item{}, err
@@ -32,15 +75,25 @@ func (s *ItemService) Create(ctx context.Context, item domain.Item) (domain.Item
 	return created, nil
 }
 
-func (s *ItemService) List(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error) {
+func (s *ItemService) List(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error) {
 	if err := filter.Validate(); err != nil {
-		return nil, 0, fmt.Errorf("validate filter: %w", err)
+		return domain.ItemPage{}, fmt.Errorf("validate filter: %w", err)
+	}
+	if filter.AccountID != "" {
+		if err := helpers.ParseUUID(filter.AccountID); err != nil {
+			return domain.ItemPage{}, domain.ErrInvalidID
+		}
+	}
+	if filter.Cursor != nil {
+		if err := helpers.ParseUUID(filter.Cursor.ID); err != nil {
+			return domain.ItemPage{}, domain.ErrInvalidCursor
+		}
 	}
-	items, totalCount, err := s.repo.GetAll(ctx, filter)
+	page, err := s.repo.GetAll(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return domain.ItemPage{}, err
 	}
-	return items, totalCount, nil
+	return page, nil
 }
 
 func (s *ItemService) GetByID(ctx context.Context, id string) (domain.Item, error) {
@@ -59,6 +112,14 @@ func (s *ItemService) Update(ctx context.Context, item domain.Item) (domain.Item
 		return domain.Item{}, domain.ErrInvalidID
 	}
 
+	var err error
+	if item.Category, err = lookupCategory(ctx, s.categories, item.Category, item.Type, true); err != nil {
+		return domain.Item{}, err
+	}
+	if item.Splits, err = s.lookupSplits(ctx, item.Amount, item.Splits, item.Type, true); err != nil {
+		return domain.Item{}, err
+	}
+
 	updated, err := s.repo.Update(ctx, item)
 	if err != nil {
 		return domain.Item{}, err
@@ -66,6 +127,160 @@ func (s *ItemService) Update(ctx context.Context, item domain.Item) (domain.Item
 	return updated, nil
 }
 
+// Patch применяет частичное изменение; пустой патч ничего не меняет и возвращает текущую запись.
+func (s *ItemService) Patch(ctx context.Context, patch domain.ItemPatch) (domain.Item, error) {
+	if err := helpers.ParseUUID(patch.ID); err != nil {
+		return domain.Item{}, domain.ErrInvalidID
+	}
+	if patch.IsEmpty() {
+		item, err := s.repo.GetByID(ctx, patch.ID)
+		if err != nil {
+			return domain.Item{}, err
+		}
+		if patch.Version != 0 && patch.Version != item.Version {
+			return domain.Item{}, domain.ErrConflict
+		}
+		return item, nil
+	}
+	current := s.currentItem(ctx, patch.ID)
+	if err := s.checkPatchCategory(ctx, &patch, current); err != nil {
+		return domain.Item{}, err
+	}
+	if err := s.checkPatchSplits(ctx, &patch, current); err != nil {
+		return domain.Item{}, err
+	}
+
+	patched, err := s.repo.Patch(ctx, patch)
+	if err != nil {
+		return domain.Item{}, err
+	}
+	return patched, nil
+}
+
+// currentItem возвращает загрузчик текущей записи: запись читается не больше одного раза
+// и только если она действительно нужна для проверки патча.
+func (s *ItemService) currentItem(ctx context.Context, id string) func() (domain.Item, error) {
+	var (
+		item   domain.Item
+		err    error
+		loaded bool
+	)
+	return func() (domain.Item, error) {
+		if !loaded {
+			item, err = s.repo.GetByID(ctx, id)
+			loaded = true
+		}
+		return item, err
+	}
+}
+
+// checkPatchCategory проверяет категорию, если патч меняет тип или категорию;
+// недостающее из пары берётся из текущей записи.
+func (s *ItemService) checkPatchCategory(
+	ctx context.Context, patch *domain.ItemPatch, current func() (domain.Item, error),
+) error {
+	if patch.Type == nil && patch.Category == nil {
+		return nil
+	}
+
+	var itemType, category string
+	if patch.Type == nil || patch.Category == nil {
+		item, err := current()
+		if err != nil {
+			return err
+		}
+		itemType, category = item.Type, item.Category
+	}
+	if patch.Type != nil {
+		itemType = *patch.Type
+	}
+	if patch.Category != nil {
+		category = *patch.Category
+	}
+
+	name, err := lookupCategory(ctx, s.categories, category, itemType, true)
+	if err != nil {
+		return err
+	}
+	if patch.Category != nil {
+		patch.Category = &name
+	}
+	return nil
+}
+
+// checkPatchSplits проверяет разбивку, если патч меняет её, сумму или тип записи:
+// итоговая разбивка должна сходиться с итоговой суммой. Недостающее берётся из текущей записи.
+func (s *ItemService) checkPatchSplits(
+	ctx context.Context, patch *domain.ItemPatch, current func() (domain.Item, error),
+) error {
+	if patch.Splits == nil && patch.Amount == nil && patch.Type == nil {
+		return nil
+	}
+
+	var (
+		amount   decimal.Decimal
+		splits   []domain.ItemSplit
+		itemType string
+	)
+	if patch.Splits == nil || patch.Amount == nil || patch.Type == nil {
+		item, err := current()
+		if err != nil {
+			return err
+		}
+		amount, splits, itemType = item.Amount, item.Splits, item.Type
+	}
+	if patch.Amount != nil {
+		amount = *patch.Amount
+	}
+	if patch.Splits != nil {
+		splits = *patch.Splits
+	}
+	if patch.Type != nil {
+		itemType = *patch.Type
+	}
+
+	resolved, err := s.lookupSplits(ctx, amount, splits, itemType, true)
+	if err != nil {
+		return err
+	}
+	if patch.Splits != nil {
+		patch.Splits = &resolved
+	}
+	return nil
+}
+
+// lookupSplits проверяет разбивку записи и возвращает её с каноническими именами категорий.
+func (s *ItemService) lookupSplits(
+	ctx context.Context, amount decimal.Decimal, splits []domain.ItemSplit, itemType string, allowArchived bool,
+) ([]domain.ItemSplit, error) {
+	return resolveSplits(amount, splits, func(name string) (string, error) {
+		return lookupCategory(ctx, s.categories, name, itemType, allowArchived)
+	})
+}
+
+// resolveSplits проверяет, что строки разбивки сходятся с суммой записи, и приводит их категории
+// через resolve. Исходный срез не меняется: он может быть общим с вызывающим кодом.
+func resolveSplits(
+	amount decimal.Decimal, splits []domain.ItemSplit, resolve func(name string) (string, error),
+) ([]domain.ItemSplit, error) {
+	if err := domain.ValidateSplits(amount, splits); err != nil {
+		return nil, err
+	}
+	if len(splits) == 0 {
+		return splits, nil
+	}
+
+	resolved := make([]domain.ItemSplit, len(splits))
+	for i, split := range splits {
+		name, err := resolve(split.Category)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = domain.ItemSplit{Category: name, Amount: split.Amount}
+	}
+	return resolved, nil
+}
+
 func (s *ItemService) Delete(ctx context.Context, id string) error {
 	if err := helpers.ParseUUID(id); err != nil {
 		return domain.ErrInvalidID
@@ -75,3 +290,165 @@ func (s *ItemService) Delete(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// Batch выполняет пакет операций. В атомарном режиме пакет идёт одной транзакцией и первая
+// ошибка возвращается как *domain.BatchOpError; иначе каждая строка выполняется отдельно,
+// а её ошибка попадает в BatchResult.Err.
+func (s *ItemService) Batch(ctx context.Context, ops []domain.BatchOperation, atomic bool) ([]domain.BatchResult, error) {
+	if len(ops) > domain.MaxBatchSize {
+		return nil, domain.ErrInvalidBatchSize
+	}
+
+	if atomic {
+		cats, err := loadCategorySet(ctx, s.categories)
+		if err != nil {
+			return nil, err
+		}
+		resolved := make([]domain.BatchOperation, len(ops))
+		copy(resolved, ops)
+		for i, op := range ops {
+			if op.Op != domain.BatchOpCreate {
+				if err = helpers.ParseUUID(op.Item.ID); err != nil {
+					return nil, &domain.BatchOpError{Index: op.Index, Err: domain.ErrInvalidID}
+				}
+			}
+			if op.Op == domain.BatchOpDelete {
+				continue
+			}
+			allowArchived := op.Op == domain.BatchOpUpdate
+			if resolved[i].Item.Category, err = cats.resolve(op.Item.Category, op.Item.Type, allowArchived); err != nil {
+				return nil, &domain.BatchOpError{Index: op.Index, Err: err}
+			}
+			if resolved[i].Item.Splits, err = cats.resolveSplits(op.Item, allowArchived); err != nil {
+				return nil, &domain.BatchOpError{Index: op.Index, Err: err}
+			}
+		}
+		return s.repo.ApplyBatch(ctx, resolved)
+	}
+
+	results := make([]domain.BatchResult, 0, len(ops))
+	for _, op := range ops {
+		res := domain.BatchResult{Index: op.Index, Op: op.Op}
+		switch op.Op {
+		case domain.BatchOpCreate:
+			created, err := s.Create(ctx, op.Item)
+			if err != nil {
+				res.Err = err
+			} else {
+				res.Item = &created
+			}
+		case domain.BatchOpUpdate:
+			updated, err := s.Update(ctx, op.Item)
+			if err != nil {
+				res.Err = err
+			} else {
+				res.Item = &updated
+			}
+		case domain.BatchOpDelete:
+			res.Err = s.Delete(ctx, op.Item.ID)
+		default:
+			res.Err = domain.ErrInvalidBatchOp
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// CheckCategories проверяет категории записей перед импортом: i-й элемент результата —
+// ошибка i-й записи или nil.
+func (s *ItemService) CheckCategories(ctx context.Context, items []domain.Item) ([]error, error) {
+	cats, err := loadCategorySet(ctx, s.categories)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]error, len(items))
+	for i, item := range items {
+		_, errs[i] = cats.resolve(item.Category, item.Type, false)
+	}
+	return errs, nil
+}
+
+// Import создаёт записи одной транзакцией: либо все, либо ни одной.
+func (s *ItemService) Import(ctx context.Context, items []domain.Item) ([]domain.Item, error) {
+	cats, err := loadCategorySet(ctx, s.categories)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]domain.BatchOperation, len(items))
+	for i, item := range items {
+		if item.Category, err = cats.resolve(item.Category, item.Type, false); err != nil {
+			return nil, &domain.BatchOpError{Index: i, Err: err}
+		}
+		if item.Splits, err = cats.resolveSplits(item, false); err != nil {
+			return nil, &domain.BatchOpError{Index: i, Err: err}
+		}
+		ops[i] = domain.BatchOperation{Index: i, Op: domain.BatchOpCreate, Item: item}
+	}
+
+	results, err := s.repo.ApplyBatch(ctx, ops)
+	if err != nil {
+		return nil, fmt.Errorf("import items: %w", err)
+	}
+
+	created := make([]domain.Item, 0, len(results))
+	for _, res := range results {
+		created = append(created, *res.Item)
+	}
+	return created, nil
+}
+
+// Restore возвращает запись из корзины.
+func (s *ItemService) Restore(ctx context.Context, id string) (domain.Item, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.Item{}, domain.ErrInvalidID
+	}
+	restored, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		return domain.Item{}, err
+	}
+	return restored, nil
+}
+
+// Purge окончательно удаляет записи, пролежавшие в корзине дольше olderThanDays дней, и файлы их вложений.
+// Если часть файлов удалить не удалось, записи всё равно считаются удалёнными: возвращается их число
+// вместе с ошибкой ErrAttachmentCleanup.
+func (s *ItemService) Purge(ctx context.Context, olderThanDays int) (int64, error) {
+	if olderThanDays < 0 {
+		return 0, domain.ErrInvalidPurgeAge
+	}
+	purged, keys, err := s.repo.Purge(ctx, time.Now().AddDate(0, 0, -olderThanDays))
+	if err != nil {
+		return 0, err
+	}
+	if err = deleteBlobs(ctx, s.blobs, keys); err != nil {
+		return purged, err
+	}
+	return purged, nil
+}
+
+// History возвращает все изменения записи, включая удалённые записи. У записей, созданных
+// до появления истории, изменений может не быть — тогда возвращается пустой список.
+func (s *ItemService) History(ctx context.Context, id string) ([]domain.ItemHistoryEntry, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return nil, domain.ErrInvalidID
+	}
+
+	entries, err := s.repo.GetHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > 0 {
+		return entries, nil
+	}
+
+	exists, err := s.repo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrItemNotFound
+	}
+	return []domain.ItemHistoryEntry{}, nil
+}
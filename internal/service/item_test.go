@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var validUUID = "550e8400-e29b-41d4-a716-446655440000"
@@ -27,9 +29,29 @@ func newTestItem() domain.Item {
 	}
 }
 
+// newTestCategories возвращает справочник с категориями salary и rent, подходящими для любых записей.
+func newTestCategories(t *testing.T) *mockcategoryLookup {
+	cats := []domain.Category{
+		{ID: validUUID, Name: "salary"},
+		{ID: validUUID, Name: "rent"},
+	}
+	lookup := newMockcategoryLookup(t)
+	lookup.EXPECT().GetAll(mock.Anything, true).Return(cats, nil).Maybe()
+	lookup.EXPECT().GetByName(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, name string) (domain.Category, error) {
+			for _, c := range cats {
+				if strings.EqualFold(c.Name, name) {
+					return c, nil
+				}
+			}
+			return domain.Category{}, domain.ErrCategoryNotFound
+		}).Maybe()
+	return lookup
+}
+
 func TestItemService_Create_Success(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	input := newTestItem()
 	input.ID = ""
@@ -44,7 +66,7 @@ func TestItemService_Create_Success(t *testing.T) {
 
 func TestItemService_Create_RepoError(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	input := newTestItem()
 	repoErr := errors.New("db connection failed")
@@ -55,35 +77,219 @@ func TestItemService_Create_RepoError(t *testing.T) {
 	assert.ErrorIs(t, err, repoErr)
 }
 
+func TestItemService_Create_UnknownCategory(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	input := newTestItem()
+	input.Category = "misc"
+
+	_, err := svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrUnknownCategory)
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestItemService_Create_NormalizesCategoryName(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	input := newTestItem()
+	input.Category = "Salary"
+	expected := input
+	expected.Category = "salary"
+
+	repo.EXPECT().Create(mock.Anything, expected).Return(expected, nil)
+
+	result, err := svc.Create(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, "salary", result.Category)
+}
+
+func TestItemService_Create_CategoryRules(t *testing.T) {
+	repo := newMockitemRepository(t)
+	lookup := newMockcategoryLookup(t)
+	svc := NewItemService(repo, lookup, newMockblobRemover(t))
+
+	lookup.EXPECT().GetByName(mock.Anything, "old").
+		Return(domain.Category{Name: "old", Archived: true}, nil)
+	lookup.EXPECT().GetByName(mock.Anything, "rent").
+		Return(domain.Category{Name: "rent", Type: domain.TypeExpense}, nil)
+
+	input := newTestItem()
+	input.Category = "old"
+	_, err := svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrCategoryArchived)
+
+	input.Category = "rent"
+	_, err = svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrCategoryType)
+}
+
+func TestItemService_Update_ArchivedCategoryAllowed(t *testing.T) {
+	repo := newMockitemRepository(t)
+	lookup := newMockcategoryLookup(t)
+	svc := NewItemService(repo, lookup, newMockblobRemover(t))
+
+	input := newTestItem()
+	lookup.EXPECT().GetByName(mock.Anything, "salary").
+		Return(domain.Category{Name: "salary", Archived: true}, nil)
+	repo.EXPECT().Update(mock.Anything, input).Return(input, nil)
+
+	_, err := svc.Update(context.Background(), input)
+	assert.NoError(t, err)
+}
+
+func TestItemService_CreateScheduled_ArchivedCategoryAllowed(t *testing.T) {
+	repo := newMockitemRepository(t)
+	lookup := newMockcategoryLookup(t)
+	svc := NewItemService(repo, lookup, newMockblobRemover(t))
+
+	input := newTestItem()
+	input.Category = "Salary"
+	lookup.EXPECT().GetByName(mock.Anything, "Salary").
+		Return(domain.Category{Name: "salary", Archived: true}, nil)
+	repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(i domain.Item) bool {
+		return i.Category == "salary"
+	})).Return(input, nil)
+
+	_, err := svc.CreateScheduled(context.Background(), input)
+	assert.NoError(t, err)
+}
+
+func TestItemService_Create_Splits(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	input := newTestItem()
+	input.Splits = []domain.ItemSplit{
+		{Category: "Salary", Amount: decimal.NewFromInt(70)},
+		{Category: "rent", Amount: decimal.NewFromInt(20)},
+	}
+	_, err := svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrSplitSum)
+
+	input.Splits[1].Amount = decimal.NewFromInt(30)
+	expected := input
+	expected.Splits = []domain.ItemSplit{
+		{Category: "salary", Amount: decimal.NewFromInt(70)},
+		{Category: "rent", Amount: decimal.NewFromInt(30)},
+	}
+	repo.EXPECT().Create(mock.Anything, expected).Return(expected, nil)
+
+	result, err := svc.Create(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Splits, result.Splits)
+	assert.Equal(t, "Salary", input.Splits[0].Category, "caller's splits must not be modified")
+}
+
+func TestItemService_Create_SplitUnknownCategory(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	input := newTestItem()
+	input.Splits = []domain.ItemSplit{{Category: "misc", Amount: decimal.NewFromInt(100)}}
+
+	_, err := svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrUnknownCategory)
+}
+
 func TestItemService_List_Success(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	filter := domain.ItemFilter{Type: domain.TypeIncome}
 	items := []domain.Item{newTestItem()}
 
-	repo.EXPECT().GetAll(mock.Anything, filter).Return(items, int64(1), nil)
+	total := int64(1)
+	repo.EXPECT().GetAll(mock.Anything, filter).Return(domain.ItemPage{Items: items, TotalCount: &total}, nil)
 
-	result, count, err := svc.List(context.Background(), filter)
+	page, err := svc.List(context.Background(), filter)
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, int64(1), count)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, &total, page.TotalCount)
 }
 
 func TestItemService_List_InvalidFilter(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	filter := domain.ItemFilter{Type: "invalid"}
 
-	_, _, err := svc.List(context.Background(), filter)
+	_, err := svc.List(context.Background(), filter)
 	assert.Error(t, err)
 	assert.True(t, domain.IsValidationError(err))
 }
 
+func TestItemService_List_InvalidTagMatch(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	filter := domain.ItemFilter{Tags: []string{"travel"}, TagMatch: "some"}
+
+	_, err := svc.List(context.Background(), filter)
+	assert.ErrorIs(t, err, domain.ErrInvalidTagMatch)
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestItemService_List_RelevanceRequiresQuery(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	_, err := svc.List(context.Background(), domain.ItemFilter{SortBy: domain.SortByRelevance})
+	assert.ErrorIs(t, err, domain.ErrRelevanceWithoutQuery)
+
+	filter := domain.ItemFilter{Query: "pharmacy", SortBy: domain.SortByRelevance}
+	repo.EXPECT().GetAll(mock.Anything, filter).Return(domain.ItemPage{}, nil)
+
+	_, err = svc.List(context.Background(), filter)
+	assert.NoError(t, err)
+}
+
+func TestItemService_List_InvalidAmountRange(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	minAmount, maxAmount := decimal.NewFromInt(500), decimal.NewFromInt(100)
+	negative := decimal.NewFromInt(-1)
+
+	for _, f := range []domain.FieldFilter{
+		{MinAmount: &minAmount, MaxAmount: &maxAmount},
+		{MaxAmount: &negative},
+	} {
+		_, err := svc.List(context.Background(), domain.ItemFilter{FieldFilter: f})
+		assert.ErrorIs(t, err, domain.ErrInvalidAmountRange)
+		assert.True(t, domain.IsValidationError(err))
+	}
+}
+
+func TestItemService_List_Cursor(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	cursor := domain.ItemCursor{SortBy: domain.SortByDate, Order: domain.OrderDesc, Key: "2024-01-15", ID: validUUID}
+
+	// курсор выдан для сортировки по умолчанию, а запрошена другая
+	_, err := svc.List(context.Background(), domain.ItemFilter{SortBy: domain.SortByAmount, Cursor: &cursor})
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+
+	_, err = svc.List(context.Background(), domain.ItemFilter{Offset: 10, Cursor: &cursor})
+	assert.ErrorIs(t, err, domain.ErrCursorWithOffset)
+
+	badID := cursor
+	badID.ID = "not-a-uuid"
+	_, err = svc.List(context.Background(), domain.ItemFilter{Cursor: &badID})
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+
+	filter := domain.ItemFilter{Cursor: &cursor, SkipTotal: true}
+	repo.EXPECT().GetAll(mock.Anything, filter).Return(domain.ItemPage{}, nil)
+
+	_, err = svc.List(context.Background(), filter)
+	assert.NoError(t, err)
+}
+
 func TestItemService_GetByID_Success(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	expected := newTestItem()
 
@@ -96,7 +302,7 @@ func TestItemService_GetByID_Success(t *testing.T) {
 
 func TestItemService_GetByID_InvalidUUID(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	_, err := svc.GetByID(context.Background(), "not-a-uuid")
 	assert.ErrorIs(t, err, domain.ErrInvalidID)
@@ -104,7 +310,7 @@ func TestItemService_GetByID_InvalidUUID(t *testing.T) {
 
 func TestItemService_GetByID_NotFound(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(domain.Item{}, domain.ErrItemNotFound)
 
@@ -114,7 +320,7 @@ func TestItemService_GetByID_NotFound(t *testing.T) {
 
 func TestItemService_Update_Success(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	input := newTestItem()
 	expected := newTestItem()
@@ -129,7 +335,7 @@ func TestItemService_Update_Success(t *testing.T) {
 
 func TestItemService_Update_InvalidUUID(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	input := newTestItem()
 	input.ID = "bad-id"
@@ -138,9 +344,95 @@ func TestItemService_Update_InvalidUUID(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrInvalidID)
 }
 
+func TestItemService_Patch_Success(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	description := "fixed"
+	patch := domain.ItemPatch{ID: validUUID, Description: &description}
+	item := newTestItem()
+	item.Description = description
+	repo.EXPECT().Patch(mock.Anything, patch).Return(item, nil)
+
+	result, err := svc.Patch(context.Background(), patch)
+	assert.NoError(t, err)
+	assert.Equal(t, description, result.Description)
+}
+
+func TestItemService_Patch_TypeCheckedAgainstCurrentCategory(t *testing.T) {
+	repo := newMockitemRepository(t)
+	lookup := newMockcategoryLookup(t)
+	svc := NewItemService(repo, lookup, newMockblobRemover(t))
+
+	expense := domain.TypeExpense
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestItem(), nil)
+	lookup.EXPECT().GetByName(mock.Anything, "salary").
+		Return(domain.Category{Name: "salary", Type: domain.TypeIncome}, nil)
+
+	_, err := svc.Patch(context.Background(), domain.ItemPatch{ID: validUUID, Type: &expense})
+	assert.ErrorIs(t, err, domain.ErrCategoryType)
+}
+
+func TestItemService_Patch_AmountCheckedAgainstSplits(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	current := newTestItem()
+	current.Splits = []domain.ItemSplit{
+		{Category: "salary", Amount: decimal.NewFromInt(60)},
+		{Category: "rent", Amount: decimal.NewFromInt(40)},
+	}
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(current, nil).Once()
+
+	amount := decimal.NewFromInt(120)
+	_, err := svc.Patch(context.Background(), domain.ItemPatch{ID: validUUID, Amount: &amount})
+	assert.ErrorIs(t, err, domain.ErrSplitSum)
+}
+
+func TestItemService_Patch_SplitsWithAmount(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	amount := decimal.NewFromInt(120)
+	splits := []domain.ItemSplit{
+		{Category: "salary", Amount: decimal.NewFromInt(100)},
+		{Category: "rent", Amount: decimal.NewFromInt(20)},
+	}
+	patch := domain.ItemPatch{ID: validUUID, Amount: &amount, Splits: &splits}
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestItem(), nil).Once()
+	repo.EXPECT().Patch(mock.Anything, mock.Anything).Return(newTestItem(), nil)
+
+	_, err := svc.Patch(context.Background(), patch)
+	assert.NoError(t, err)
+}
+
+func TestItemService_Patch_InvalidUUID(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	_, err := svc.Patch(context.Background(), domain.ItemPatch{ID: "bad-id"})
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestItemService_Patch_EmptyReturnsCurrent(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	item := newTestItem()
+	item.Version = 2
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(item, nil)
+
+	result, err := svc.Patch(context.Background(), domain.ItemPatch{ID: validUUID})
+	assert.NoError(t, err)
+	assert.Equal(t, item.ID, result.ID)
+
+	_, err = svc.Patch(context.Background(), domain.ItemPatch{ID: validUUID, Version: 1})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+}
+
 func TestItemService_Delete_Success(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	repo.EXPECT().Delete(mock.Anything, validUUID).Return(nil)
 
@@ -150,7 +442,7 @@ func TestItemService_Delete_Success(t *testing.T) {
 
 func TestItemService_Delete_InvalidUUID(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	err := svc.Delete(context.Background(), "bad-id")
 	assert.ErrorIs(t, err, domain.ErrInvalidID)
@@ -158,10 +450,221 @@ func TestItemService_Delete_InvalidUUID(t *testing.T) {
 
 func TestItemService_Delete_NotFound(t *testing.T) {
 	repo := newMockitemRepository(t)
-	svc := NewItemService(repo)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
 
 	repo.EXPECT().Delete(mock.Anything, validUUID).Return(domain.ErrItemNotFound)
 
 	err := svc.Delete(context.Background(), validUUID)
 	assert.ErrorIs(t, err, domain.ErrItemNotFound)
 }
+
+func TestItemService_Batch_Atomic(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	ops := []domain.BatchOperation{
+		{Index: 0, Op: domain.BatchOpCreate, Item: newTestItem()},
+		{Index: 1, Op: domain.BatchOpDelete, Item: domain.Item{ID: validUUID}},
+	}
+	results := []domain.BatchResult{{Index: 0, Op: domain.BatchOpCreate}, {Index: 1, Op: domain.BatchOpDelete}}
+	repo.EXPECT().ApplyBatch(mock.Anything, ops).Return(results, nil)
+
+	got, err := svc.Batch(context.Background(), ops, true)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestItemService_Batch_AtomicInvalidUUID(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	ops := []domain.BatchOperation{
+		{Index: 0, Op: domain.BatchOpCreate, Item: newTestItem()},
+		{Index: 1, Op: domain.BatchOpUpdate, Item: domain.Item{ID: "bad-id"}},
+	}
+
+	_, err := svc.Batch(context.Background(), ops, true)
+
+	var opErr *domain.BatchOpError
+	require.ErrorAs(t, err, &opErr)
+	assert.Equal(t, 1, opErr.Index)
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestItemService_Batch_AtomicUnknownCategory(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	unknown := newTestItem()
+	unknown.Category = "misc"
+	ops := []domain.BatchOperation{
+		{Index: 0, Op: domain.BatchOpCreate, Item: newTestItem()},
+		{Index: 1, Op: domain.BatchOpCreate, Item: unknown},
+	}
+
+	_, err := svc.Batch(context.Background(), ops, true)
+
+	var opErr *domain.BatchOpError
+	require.ErrorAs(t, err, &opErr)
+	assert.Equal(t, 1, opErr.Index)
+	assert.ErrorIs(t, err, domain.ErrUnknownCategory)
+}
+
+func TestItemService_Batch_NonAtomicPerRow(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	item := newTestItem()
+	repo.EXPECT().Create(mock.Anything, item).Return(item, nil)
+	repo.EXPECT().Delete(mock.Anything, validUUID).Return(domain.ErrItemNotFound)
+
+	ops := []domain.BatchOperation{
+		{Index: 0, Op: domain.BatchOpCreate, Item: item},
+		{Index: 1, Op: domain.BatchOpDelete, Item: domain.Item{ID: validUUID}},
+	}
+
+	got, err := svc.Batch(context.Background(), ops, false)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.NoError(t, got[0].Err)
+	require.NotNil(t, got[0].Item)
+	assert.ErrorIs(t, got[1].Err, domain.ErrItemNotFound)
+}
+
+func TestItemService_Import_Success(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	item := newTestItem()
+	created := item
+	created.ID = validUUID
+	repo.EXPECT().ApplyBatch(mock.Anything, []domain.BatchOperation{
+		{Index: 0, Op: domain.BatchOpCreate, Item: item},
+	}).Return([]domain.BatchResult{{Index: 0, Op: domain.BatchOpCreate, Item: &created}}, nil)
+
+	got, err := svc.Import(context.Background(), []domain.Item{item})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, validUUID, got[0].ID)
+}
+
+func TestItemService_CheckCategories(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	unknown := newTestItem()
+	unknown.Category = "misc"
+
+	errs, err := svc.CheckCategories(context.Background(), []domain.Item{newTestItem(), unknown})
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], domain.ErrUnknownCategory)
+}
+
+func TestItemService_Restore_Success(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	item := newTestItem()
+	repo.EXPECT().Restore(mock.Anything, validUUID).Return(item, nil)
+
+	result, err := svc.Restore(context.Background(), validUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, item.ID, result.ID)
+}
+
+func TestItemService_Restore_InvalidUUID(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	_, err := svc.Restore(context.Background(), "bad-id")
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestItemService_Purge_Success(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	before := time.Now().AddDate(0, 0, -30)
+	repo.EXPECT().Purge(mock.Anything, mock.MatchedBy(func(olderThan time.Time) bool {
+		return !olderThan.Before(before) && olderThan.Before(before.Add(time.Minute))
+	})).Return(3, nil, nil)
+
+	purged, err := svc.Purge(context.Background(), 30)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+}
+
+func TestItemService_Purge_DeletesAttachmentFiles(t *testing.T) {
+	repo := newMockitemRepository(t)
+	blobs := newMockblobRemover(t)
+	svc := NewItemService(repo, newTestCategories(t), blobs)
+
+	repo.EXPECT().Purge(mock.Anything, mock.Anything).Return(2, []string{"a/1", "b/2"}, nil)
+	blobs.EXPECT().Delete(mock.Anything, "a/1").Return(errors.New("disk error"))
+	blobs.EXPECT().Delete(mock.Anything, "b/2").Return(nil)
+
+	purged, err := svc.Purge(context.Background(), 30)
+	assert.ErrorIs(t, err, domain.ErrAttachmentCleanup)
+	assert.Equal(t, int64(2), purged)
+}
+
+func TestItemService_Purge_NegativeDays(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	_, err := svc.Purge(context.Background(), -1)
+	assert.ErrorIs(t, err, domain.ErrInvalidPurgeAge)
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestItemService_History_Success(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	item := newTestItem()
+	entries := []domain.ItemHistoryEntry{
+		{ID: 1, ItemID: validUUID, Action: domain.HistoryActionCreate, NewValue: &item, RequestID: "req-1"},
+		{ID: 2, ItemID: validUUID, Action: domain.HistoryActionDelete, OldValue: &item, RequestID: "req-2"},
+	}
+	repo.EXPECT().GetHistory(mock.Anything, validUUID).Return(entries, nil)
+
+	result, err := svc.History(context.Background(), validUUID)
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, domain.HistoryActionDelete, result[1].Action)
+}
+
+func TestItemService_History_InvalidUUID(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	_, err := svc.History(context.Background(), "bad-id")
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestItemService_History_NotFound(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	repo.EXPECT().GetHistory(mock.Anything, validUUID).Return(nil, nil)
+	repo.EXPECT().Exists(mock.Anything, validUUID).Return(false, nil)
+
+	_, err := svc.History(context.Background(), validUUID)
+	assert.ErrorIs(t, err, domain.ErrItemNotFound)
+}
+
+func TestItemService_History_NoEntries(t *testing.T) {
+	repo := newMockitemRepository(t)
+	svc := NewItemService(repo, newTestCategories(t), newMockblobRemover(t))
+
+	// запись создана до появления истории
+	repo.EXPECT().GetHistory(mock.Anything, validUUID).Return(nil, nil)
+	repo.EXPECT().Exists(mock.Anything, validUUID).Return(true, nil)
+
+	result, err := svc.History(context.Background(), validUUID)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
@@ -6,19 +6,20 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/stpnv0/SalesTracker/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// newMockanalyticsRepository creates a new instance of mockanalyticsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// newMockaccountLookup creates a new instance of mockaccountLookup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func newMockanalyticsRepository(t interface {
+func newMockaccountLookup(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *mockanalyticsRepository {
-	mock := &mockanalyticsRepository{}
+}) *mockaccountLookup {
+	mock := &mockaccountLookup{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -26,257 +27,231 @@ func newMockanalyticsRepository(t interface {
 	return mock
 }
 
-// mockanalyticsRepository is an autogenerated mock type for the analyticsRepository type
-type mockanalyticsRepository struct {
+// mockaccountLookup is an autogenerated mock type for the accountLookup type
+type mockaccountLookup struct {
 	mock.Mock
 }
 
-type mockanalyticsRepository_Expecter struct {
+type mockaccountLookup_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *mockanalyticsRepository) EXPECT() *mockanalyticsRepository_Expecter {
-	return &mockanalyticsRepository_Expecter{mock: &_m.Mock}
+func (_m *mockaccountLookup) EXPECT() *mockaccountLookup_Expecter {
+	return &mockaccountLookup_Expecter{mock: &_m.Mock}
 }
 
-// Aggregate provides a mock function for the type mockanalyticsRepository
-func (_mock *mockanalyticsRepository) Aggregate(ctx context.Context, from time.Time, to time.Time, itemType string) (domain.AnalyticsResult, error) {
-	ret := _mock.Called(ctx, from, to, itemType)
+// GetByID provides a mock function for the type mockaccountLookup
+func (_mock *mockaccountLookup) GetByID(ctx context.Context, id string) (domain.Account, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Aggregate")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 domain.AnalyticsResult
+	var r0 domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) (domain.AnalyticsResult, error)); ok {
-		return returnFunc(ctx, from, to, itemType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Account, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) domain.AnalyticsResult); ok {
-		r0 = returnFunc(ctx, from, to, itemType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Account); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(domain.AnalyticsResult)
+		r0 = ret.Get(0).(domain.Account)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
-		r1 = returnFunc(ctx, from, to, itemType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockanalyticsRepository_Aggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Aggregate'
-type mockanalyticsRepository_Aggregate_Call struct {
+// mockaccountLookup_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockaccountLookup_GetByID_Call struct {
 	*mock.Call
 }
 
-// Aggregate is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - from time.Time
-//   - to time.Time
-//   - itemType string
-func (_e *mockanalyticsRepository_Expecter) Aggregate(ctx interface{}, from interface{}, to interface{}, itemType interface{}) *mockanalyticsRepository_Aggregate_Call {
-	return &mockanalyticsRepository_Aggregate_Call{Call: _e.mock.On("Aggregate", ctx, from, to, itemType)}
+//   - id string
+func (_e *mockaccountLookup_Expecter) GetByID(ctx interface{}, id interface{}) *mockaccountLookup_GetByID_Call {
+	return &mockaccountLookup_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *mockanalyticsRepository_Aggregate_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, itemType string)) *mockanalyticsRepository_Aggregate_Call {
+func (_c *mockaccountLookup_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockaccountLookup_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 time.Time
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(time.Time)
-		}
-		var arg2 time.Time
-		if args[2] != nil {
-			arg2 = args[2].(time.Time)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *mockanalyticsRepository_Aggregate_Call) Return(analyticsResult domain.AnalyticsResult, err error) *mockanalyticsRepository_Aggregate_Call {
-	_c.Call.Return(analyticsResult, err)
+func (_c *mockaccountLookup_GetByID_Call) Return(account domain.Account, err error) *mockaccountLookup_GetByID_Call {
+	_c.Call.Return(account, err)
 	return _c
 }
 
-func (_c *mockanalyticsRepository_Aggregate_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time, itemType string) (domain.AnalyticsResult, error)) *mockanalyticsRepository_Aggregate_Call {
+func (_c *mockaccountLookup_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Account, error)) *mockaccountLookup_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// AggregateGrouped provides a mock function for the type mockanalyticsRepository
-func (_mock *mockanalyticsRepository) AggregateGrouped(ctx context.Context, from time.Time, to time.Time, groupBy string, itemType string) ([]domain.GroupedAnalytics, error) {
-	ret := _mock.Called(ctx, from, to, groupBy, itemType)
+// newMockaccountRepository creates a new instance of mockaccountRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockaccountRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockaccountRepository {
+	mock := &mockaccountRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockaccountRepository is an autogenerated mock type for the accountRepository type
+type mockaccountRepository struct {
+	mock.Mock
+}
+
+type mockaccountRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockaccountRepository) EXPECT() *mockaccountRepository_Expecter {
+	return &mockaccountRepository_Expecter{mock: &_m.Mock}
+}
+
+// Balance provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) Balance(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error) {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AggregateGrouped")
+		panic("no return value specified for Balance")
 	}
 
-	var r0 []domain.GroupedAnalytics
+	var r0 domain.AccountBalance
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string, string) ([]domain.GroupedAnalytics, error)); ok {
-		return returnFunc(ctx, from, to, groupBy, itemType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (domain.AccountBalance, error)); ok {
+		return returnFunc(ctx, id, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string, string) []domain.GroupedAnalytics); ok {
-		r0 = returnFunc(ctx, from, to, groupBy, itemType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) domain.AccountBalance); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.GroupedAnalytics)
-		}
+		r0 = ret.Get(0).(domain.AccountBalance)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string, string) error); ok {
-		r1 = returnFunc(ctx, from, to, groupBy, itemType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockanalyticsRepository_AggregateGrouped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregateGrouped'
-type mockanalyticsRepository_AggregateGrouped_Call struct {
+// mockaccountRepository_Balance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Balance'
+type mockaccountRepository_Balance_Call struct {
 	*mock.Call
 }
 
-// AggregateGrouped is a helper method to define mock.On call
+// Balance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - from time.Time
-//   - to time.Time
-//   - groupBy string
-//   - itemType string
-func (_e *mockanalyticsRepository_Expecter) AggregateGrouped(ctx interface{}, from interface{}, to interface{}, groupBy interface{}, itemType interface{}) *mockanalyticsRepository_AggregateGrouped_Call {
-	return &mockanalyticsRepository_AggregateGrouped_Call{Call: _e.mock.On("AggregateGrouped", ctx, from, to, groupBy, itemType)}
+//   - id string
+//   - at time.Time
+func (_e *mockaccountRepository_Expecter) Balance(ctx interface{}, id interface{}, at interface{}) *mockaccountRepository_Balance_Call {
+	return &mockaccountRepository_Balance_Call{Call: _e.mock.On("Balance", ctx, id, at)}
 }
 
-func (_c *mockanalyticsRepository_AggregateGrouped_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, groupBy string, itemType string)) *mockanalyticsRepository_AggregateGrouped_Call {
+func (_c *mockaccountRepository_Balance_Call) Run(run func(ctx context.Context, id string, at time.Time)) *mockaccountRepository_Balance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 time.Time
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(time.Time)
+			arg1 = args[1].(string)
 		}
 		var arg2 time.Time
 		if args[2] != nil {
 			arg2 = args[2].(time.Time)
 		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
-		var arg4 string
-		if args[4] != nil {
-			arg4 = args[4].(string)
-		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *mockanalyticsRepository_AggregateGrouped_Call) Return(groupedAnalyticss []domain.GroupedAnalytics, err error) *mockanalyticsRepository_AggregateGrouped_Call {
-	_c.Call.Return(groupedAnalyticss, err)
+func (_c *mockaccountRepository_Balance_Call) Return(accountBalance domain.AccountBalance, err error) *mockaccountRepository_Balance_Call {
+	_c.Call.Return(accountBalance, err)
 	return _c
 }
 
-func (_c *mockanalyticsRepository_AggregateGrouped_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time, groupBy string, itemType string) ([]domain.GroupedAnalytics, error)) *mockanalyticsRepository_AggregateGrouped_Call {
+func (_c *mockaccountRepository_Balance_Call) RunAndReturn(run func(ctx context.Context, id string, at time.Time) (domain.AccountBalance, error)) *mockaccountRepository_Balance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// newMockitemRepository creates a new instance of mockitemRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func newMockitemRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *mockitemRepository {
-	mock := &mockitemRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// mockitemRepository is an autogenerated mock type for the itemRepository type
-type mockitemRepository struct {
-	mock.Mock
-}
-
-type mockitemRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *mockitemRepository) EXPECT() *mockitemRepository_Expecter {
-	return &mockitemRepository_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type mockitemRepository
-func (_mock *mockitemRepository) Create(ctx context.Context, item domain.Item) (domain.Item, error) {
-	ret := _mock.Called(ctx, item)
+// Create provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) Create(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	ret := _mock.Called(ctx, acc)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
-	var r0 domain.Item
+	var r0 domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
-		return returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) (domain.Account, error)); ok {
+		return returnFunc(ctx, acc)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
-		r0 = returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) domain.Account); ok {
+		r0 = returnFunc(ctx, acc)
 	} else {
-		r0 = ret.Get(0).(domain.Item)
+		r0 = ret.Get(0).(domain.Account)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
-		r1 = returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Account) error); ok {
+		r1 = returnFunc(ctx, acc)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockitemRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type mockitemRepository_Create_Call struct {
+// mockaccountRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockaccountRepository_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - item domain.Item
-func (_e *mockitemRepository_Expecter) Create(ctx interface{}, item interface{}) *mockitemRepository_Create_Call {
-	return &mockitemRepository_Create_Call{Call: _e.mock.On("Create", ctx, item)}
+//   - acc domain.Account
+func (_e *mockaccountRepository_Expecter) Create(ctx interface{}, acc interface{}) *mockaccountRepository_Create_Call {
+	return &mockaccountRepository_Create_Call{Call: _e.mock.On("Create", ctx, acc)}
 }
 
-func (_c *mockitemRepository_Create_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemRepository_Create_Call {
+func (_c *mockaccountRepository_Create_Call) Run(run func(ctx context.Context, acc domain.Account)) *mockaccountRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.Item
+		var arg1 domain.Account
 		if args[1] != nil {
-			arg1 = args[1].(domain.Item)
+			arg1 = args[1].(domain.Account)
 		}
 		run(
 			arg0,
@@ -286,18 +261,18 @@ func (_c *mockitemRepository_Create_Call) Run(run func(ctx context.Context, item
 	return _c
 }
 
-func (_c *mockitemRepository_Create_Call) Return(item1 domain.Item, err error) *mockitemRepository_Create_Call {
-	_c.Call.Return(item1, err)
+func (_c *mockaccountRepository_Create_Call) Return(account domain.Account, err error) *mockaccountRepository_Create_Call {
+	_c.Call.Return(account, err)
 	return _c
 }
 
-func (_c *mockitemRepository_Create_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemRepository_Create_Call {
+func (_c *mockaccountRepository_Create_Call) RunAndReturn(run func(ctx context.Context, acc domain.Account) (domain.Account, error)) *mockaccountRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type mockitemRepository
-func (_mock *mockitemRepository) Delete(ctx context.Context, id string) error {
+// Delete provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) Delete(ctx context.Context, id string) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
@@ -313,19 +288,19 @@ func (_mock *mockitemRepository) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
-// mockitemRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type mockitemRepository_Delete_Call struct {
+// mockaccountRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockaccountRepository_Delete_Call struct {
 	*mock.Call
 }
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *mockitemRepository_Expecter) Delete(ctx interface{}, id interface{}) *mockitemRepository_Delete_Call {
-	return &mockitemRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *mockaccountRepository_Expecter) Delete(ctx interface{}, id interface{}) *mockaccountRepository_Delete_Call {
+	return &mockaccountRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *mockitemRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mockitemRepository_Delete_Call {
+func (_c *mockaccountRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mockaccountRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -343,71 +318,65 @@ func (_c *mockitemRepository_Delete_Call) Run(run func(ctx context.Context, id s
 	return _c
 }
 
-func (_c *mockitemRepository_Delete_Call) Return(err error) *mockitemRepository_Delete_Call {
+func (_c *mockaccountRepository_Delete_Call) Return(err error) *mockaccountRepository_Delete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *mockitemRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockitemRepository_Delete_Call {
+func (_c *mockaccountRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockaccountRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function for the type mockitemRepository
-func (_mock *mockitemRepository) GetAll(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error) {
-	ret := _mock.Called(ctx, filter)
+// GetAll provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) GetAll(ctx context.Context, includeClosed bool) ([]domain.Account, error) {
+	ret := _mock.Called(ctx, includeClosed)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
-	var r0 []domain.Item
-	var r1 int64
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) ([]domain.Item, int64, error)); ok {
-		return returnFunc(ctx, filter)
+	var r0 []domain.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]domain.Account, error)); ok {
+		return returnFunc(ctx, includeClosed)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) []domain.Item); ok {
-		r0 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []domain.Account); ok {
+		r0 = returnFunc(ctx, includeClosed)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Item)
+			r0 = ret.Get(0).([]domain.Account)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemFilter) int64); ok {
-		r1 = returnFunc(ctx, filter)
-	} else {
-		r1 = ret.Get(1).(int64)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.ItemFilter) error); ok {
-		r2 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeClosed)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// mockitemRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
-type mockitemRepository_GetAll_Call struct {
+// mockaccountRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type mockaccountRepository_GetAll_Call struct {
 	*mock.Call
 }
 
 // GetAll is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter domain.ItemFilter
-func (_e *mockitemRepository_Expecter) GetAll(ctx interface{}, filter interface{}) *mockitemRepository_GetAll_Call {
-	return &mockitemRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, filter)}
+//   - includeClosed bool
+func (_e *mockaccountRepository_Expecter) GetAll(ctx interface{}, includeClosed interface{}) *mockaccountRepository_GetAll_Call {
+	return &mockaccountRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, includeClosed)}
 }
 
-func (_c *mockitemRepository_GetAll_Call) Run(run func(ctx context.Context, filter domain.ItemFilter)) *mockitemRepository_GetAll_Call {
+func (_c *mockaccountRepository_GetAll_Call) Run(run func(ctx context.Context, includeClosed bool)) *mockaccountRepository_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.ItemFilter
+		var arg1 bool
 		if args[1] != nil {
-			arg1 = args[1].(domain.ItemFilter)
+			arg1 = args[1].(bool)
 		}
 		run(
 			arg0,
@@ -417,33 +386,33 @@ func (_c *mockitemRepository_GetAll_Call) Run(run func(ctx context.Context, filt
 	return _c
 }
 
-func (_c *mockitemRepository_GetAll_Call) Return(items []domain.Item, n int64, err error) *mockitemRepository_GetAll_Call {
-	_c.Call.Return(items, n, err)
+func (_c *mockaccountRepository_GetAll_Call) Return(accounts []domain.Account, err error) *mockaccountRepository_GetAll_Call {
+	_c.Call.Return(accounts, err)
 	return _c
 }
 
-func (_c *mockitemRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, filter domain.ItemFilter) ([]domain.Item, int64, error)) *mockitemRepository_GetAll_Call {
+func (_c *mockaccountRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, includeClosed bool) ([]domain.Account, error)) *mockaccountRepository_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type mockitemRepository
-func (_mock *mockitemRepository) GetByID(ctx context.Context, id string) (domain.Item, error) {
+// GetByID provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) GetByID(ctx context.Context, id string) (domain.Account, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
 	}
 
-	var r0 domain.Item
+	var r0 domain.Account
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Item, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Account, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Item); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Account); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(domain.Item)
+		r0 = ret.Get(0).(domain.Account)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = returnFunc(ctx, id)
@@ -453,19 +422,19 @@ func (_mock *mockitemRepository) GetByID(ctx context.Context, id string) (domain
 	return r0, r1
 }
 
-// mockitemRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type mockitemRepository_GetByID_Call struct {
+// mockaccountRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockaccountRepository_GetByID_Call struct {
 	*mock.Call
 }
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *mockitemRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mockitemRepository_GetByID_Call {
-	return &mockitemRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *mockaccountRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mockaccountRepository_GetByID_Call {
+	return &mockaccountRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *mockitemRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockitemRepository_GetByID_Call {
+func (_c *mockaccountRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockaccountRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -483,63 +452,63 @@ func (_c *mockitemRepository_GetByID_Call) Run(run func(ctx context.Context, id
 	return _c
 }
 
-func (_c *mockitemRepository_GetByID_Call) Return(item domain.Item, err error) *mockitemRepository_GetByID_Call {
-	_c.Call.Return(item, err)
+func (_c *mockaccountRepository_GetByID_Call) Return(account domain.Account, err error) *mockaccountRepository_GetByID_Call {
+	_c.Call.Return(account, err)
 	return _c
 }
 
-func (_c *mockitemRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Item, error)) *mockitemRepository_GetByID_Call {
+func (_c *mockaccountRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Account, error)) *mockaccountRepository_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type mockitemRepository
-func (_mock *mockitemRepository) Update(ctx context.Context, item domain.Item) (domain.Item, error) {
-	ret := _mock.Called(ctx, item)
+// HasItems provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) HasItems(ctx context.Context, id string) (bool, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for HasItems")
 	}
 
-	var r0 domain.Item
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
-		return returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
-		r0 = returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(domain.Item)
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
-		r1 = returnFunc(ctx, item)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mockitemRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type mockitemRepository_Update_Call struct {
+// mockaccountRepository_HasItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasItems'
+type mockaccountRepository_HasItems_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// HasItems is a helper method to define mock.On call
 //   - ctx context.Context
-//   - item domain.Item
-func (_e *mockitemRepository_Expecter) Update(ctx interface{}, item interface{}) *mockitemRepository_Update_Call {
-	return &mockitemRepository_Update_Call{Call: _e.mock.On("Update", ctx, item)}
+//   - id string
+func (_e *mockaccountRepository_Expecter) HasItems(ctx interface{}, id interface{}) *mockaccountRepository_HasItems_Call {
+	return &mockaccountRepository_HasItems_Call{Call: _e.mock.On("HasItems", ctx, id)}
 }
 
-func (_c *mockitemRepository_Update_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemRepository_Update_Call {
+func (_c *mockaccountRepository_HasItems_Call) Run(run func(ctx context.Context, id string)) *mockaccountRepository_HasItems_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.Item
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(domain.Item)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -549,12 +518,3757 @@ func (_c *mockitemRepository_Update_Call) Run(run func(ctx context.Context, item
 	return _c
 }
 
-func (_c *mockitemRepository_Update_Call) Return(item1 domain.Item, err error) *mockitemRepository_Update_Call {
-	_c.Call.Return(item1, err)
+func (_c *mockaccountRepository_HasItems_Call) Return(b bool, err error) *mockaccountRepository_HasItems_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *mockitemRepository_Update_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemRepository_Update_Call {
+func (_c *mockaccountRepository_HasItems_Call) RunAndReturn(run func(ctx context.Context, id string) (bool, error)) *mockaccountRepository_HasItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Statement provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) Statement(ctx context.Context, filter domain.StatementFilter) ([]domain.StatementEntry, int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Statement")
+	}
+
+	var r0 []domain.StatementEntry
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatementFilter) ([]domain.StatementEntry, int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StatementFilter) []domain.StatementEntry); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StatementEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StatementFilter) int64); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.StatementFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// mockaccountRepository_Statement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statement'
+type mockaccountRepository_Statement_Call struct {
+	*mock.Call
+}
+
+// Statement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.StatementFilter
+func (_e *mockaccountRepository_Expecter) Statement(ctx interface{}, filter interface{}) *mockaccountRepository_Statement_Call {
+	return &mockaccountRepository_Statement_Call{Call: _e.mock.On("Statement", ctx, filter)}
+}
+
+func (_c *mockaccountRepository_Statement_Call) Run(run func(ctx context.Context, filter domain.StatementFilter)) *mockaccountRepository_Statement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StatementFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.StatementFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockaccountRepository_Statement_Call) Return(statementEntrys []domain.StatementEntry, n int64, err error) *mockaccountRepository_Statement_Call {
+	_c.Call.Return(statementEntrys, n, err)
+	return _c
+}
+
+func (_c *mockaccountRepository_Statement_Call) RunAndReturn(run func(ctx context.Context, filter domain.StatementFilter) ([]domain.StatementEntry, int64, error)) *mockaccountRepository_Statement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockaccountRepository
+func (_mock *mockaccountRepository) Update(ctx context.Context, acc domain.Account) (domain.Account, error) {
+	ret := _mock.Called(ctx, acc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) (domain.Account, error)); ok {
+		return returnFunc(ctx, acc)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Account) domain.Account); ok {
+		r0 = returnFunc(ctx, acc)
+	} else {
+		r0 = ret.Get(0).(domain.Account)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Account) error); ok {
+		r1 = returnFunc(ctx, acc)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockaccountRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockaccountRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - acc domain.Account
+func (_e *mockaccountRepository_Expecter) Update(ctx interface{}, acc interface{}) *mockaccountRepository_Update_Call {
+	return &mockaccountRepository_Update_Call{Call: _e.mock.On("Update", ctx, acc)}
+}
+
+func (_c *mockaccountRepository_Update_Call) Run(run func(ctx context.Context, acc domain.Account)) *mockaccountRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Account
+		if args[1] != nil {
+			arg1 = args[1].(domain.Account)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockaccountRepository_Update_Call) Return(account domain.Account, err error) *mockaccountRepository_Update_Call {
+	_c.Call.Return(account, err)
+	return _c
+}
+
+func (_c *mockaccountRepository_Update_Call) RunAndReturn(run func(ctx context.Context, acc domain.Account) (domain.Account, error)) *mockaccountRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockanalyticsRepository creates a new instance of mockanalyticsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockanalyticsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockanalyticsRepository {
+	mock := &mockanalyticsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockanalyticsRepository is an autogenerated mock type for the analyticsRepository type
+type mockanalyticsRepository struct {
+	mock.Mock
+}
+
+type mockanalyticsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockanalyticsRepository) EXPECT() *mockanalyticsRepository_Expecter {
+	return &mockanalyticsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Aggregate provides a mock function for the type mockanalyticsRepository
+func (_mock *mockanalyticsRepository) Aggregate(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.AnalyticsResult, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Aggregate")
+	}
+
+	var r0 []domain.AnalyticsResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) ([]domain.AnalyticsResult, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) []domain.AnalyticsResult); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AnalyticsResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsRepository_Aggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Aggregate'
+type mockanalyticsRepository_Aggregate_Call struct {
+	*mock.Call
+}
+
+// Aggregate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsRepository_Expecter) Aggregate(ctx interface{}, filter interface{}) *mockanalyticsRepository_Aggregate_Call {
+	return &mockanalyticsRepository_Aggregate_Call{Call: _e.mock.On("Aggregate", ctx, filter)}
+}
+
+func (_c *mockanalyticsRepository_Aggregate_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsRepository_Aggregate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsRepository_Aggregate_Call) Return(analyticsResults []domain.AnalyticsResult, err error) *mockanalyticsRepository_Aggregate_Call {
+	_c.Call.Return(analyticsResults, err)
+	return _c
+}
+
+func (_c *mockanalyticsRepository_Aggregate_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.AnalyticsResult, error)) *mockanalyticsRepository_Aggregate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AggregateCategoryTree provides a mock function for the type mockanalyticsRepository
+func (_mock *mockanalyticsRepository) AggregateCategoryTree(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CategoryTreeGroup, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregateCategoryTree")
+	}
+
+	var r0 []domain.CategoryTreeGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) ([]domain.CategoryTreeGroup, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) []domain.CategoryTreeGroup); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CategoryTreeGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsRepository_AggregateCategoryTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregateCategoryTree'
+type mockanalyticsRepository_AggregateCategoryTree_Call struct {
+	*mock.Call
+}
+
+// AggregateCategoryTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsRepository_Expecter) AggregateCategoryTree(ctx interface{}, filter interface{}) *mockanalyticsRepository_AggregateCategoryTree_Call {
+	return &mockanalyticsRepository_AggregateCategoryTree_Call{Call: _e.mock.On("AggregateCategoryTree", ctx, filter)}
+}
+
+func (_c *mockanalyticsRepository_AggregateCategoryTree_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsRepository_AggregateCategoryTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsRepository_AggregateCategoryTree_Call) Return(categoryTreeGroups []domain.CategoryTreeGroup, err error) *mockanalyticsRepository_AggregateCategoryTree_Call {
+	_c.Call.Return(categoryTreeGroups, err)
+	return _c
+}
+
+func (_c *mockanalyticsRepository_AggregateCategoryTree_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CategoryTreeGroup, error)) *mockanalyticsRepository_AggregateCategoryTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AggregateGrouped provides a mock function for the type mockanalyticsRepository
+func (_mock *mockanalyticsRepository) AggregateGrouped(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.GroupedAnalytics, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregateGrouped")
+	}
+
+	var r0 []domain.GroupedAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) ([]domain.GroupedAnalytics, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) []domain.GroupedAnalytics); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GroupedAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsRepository_AggregateGrouped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregateGrouped'
+type mockanalyticsRepository_AggregateGrouped_Call struct {
+	*mock.Call
+}
+
+// AggregateGrouped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsRepository_Expecter) AggregateGrouped(ctx interface{}, filter interface{}) *mockanalyticsRepository_AggregateGrouped_Call {
+	return &mockanalyticsRepository_AggregateGrouped_Call{Call: _e.mock.On("AggregateGrouped", ctx, filter)}
+}
+
+func (_c *mockanalyticsRepository_AggregateGrouped_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsRepository_AggregateGrouped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsRepository_AggregateGrouped_Call) Return(groupedAnalyticss []domain.GroupedAnalytics, err error) *mockanalyticsRepository_AggregateGrouped_Call {
+	_c.Call.Return(groupedAnalyticss, err)
+	return _c
+}
+
+func (_c *mockanalyticsRepository_AggregateGrouped_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.GroupedAnalytics, error)) *mockanalyticsRepository_AggregateGrouped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AggregatePivot provides a mock function for the type mockanalyticsRepository
+func (_mock *mockanalyticsRepository) AggregatePivot(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.PivotCell, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregatePivot")
+	}
+
+	var r0 []domain.PivotCell
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) ([]domain.PivotCell, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) []domain.PivotCell); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PivotCell)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsRepository_AggregatePivot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregatePivot'
+type mockanalyticsRepository_AggregatePivot_Call struct {
+	*mock.Call
+}
+
+// AggregatePivot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsRepository_Expecter) AggregatePivot(ctx interface{}, filter interface{}) *mockanalyticsRepository_AggregatePivot_Call {
+	return &mockanalyticsRepository_AggregatePivot_Call{Call: _e.mock.On("AggregatePivot", ctx, filter)}
+}
+
+func (_c *mockanalyticsRepository_AggregatePivot_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsRepository_AggregatePivot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsRepository_AggregatePivot_Call) Return(pivotCells []domain.PivotCell, err error) *mockanalyticsRepository_AggregatePivot_Call {
+	_c.Call.Return(pivotCells, err)
+	return _c
+}
+
+func (_c *mockanalyticsRepository_AggregatePivot_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.PivotCell, error)) *mockanalyticsRepository_AggregatePivot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cashflow provides a mock function for the type mockanalyticsRepository
+func (_mock *mockanalyticsRepository) Cashflow(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CashflowBucket, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cashflow")
+	}
+
+	var r0 []domain.CashflowBucket
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) ([]domain.CashflowBucket, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) []domain.CashflowBucket); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CashflowBucket)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsRepository_Cashflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cashflow'
+type mockanalyticsRepository_Cashflow_Call struct {
+	*mock.Call
+}
+
+// Cashflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsRepository_Expecter) Cashflow(ctx interface{}, filter interface{}) *mockanalyticsRepository_Cashflow_Call {
+	return &mockanalyticsRepository_Cashflow_Call{Call: _e.mock.On("Cashflow", ctx, filter)}
+}
+
+func (_c *mockanalyticsRepository_Cashflow_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsRepository_Cashflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsRepository_Cashflow_Call) Return(cashflowBuckets []domain.CashflowBucket, err error) *mockanalyticsRepository_Cashflow_Call {
+	_c.Call.Return(cashflowBuckets, err)
+	return _c
+}
+
+func (_c *mockanalyticsRepository_Cashflow_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.CashflowBucket, error)) *mockanalyticsRepository_Cashflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUnconverted provides a mock function for the type mockanalyticsRepository
+func (_mock *mockanalyticsRepository) FindUnconverted(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.UnconvertedItem, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnconverted")
+	}
+
+	var r0 []domain.UnconvertedItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) ([]domain.UnconvertedItem, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsFilter) []domain.UnconvertedItem); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UnconvertedItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockanalyticsRepository_FindUnconverted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnconverted'
+type mockanalyticsRepository_FindUnconverted_Call struct {
+	*mock.Call
+}
+
+// FindUnconverted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AnalyticsFilter
+func (_e *mockanalyticsRepository_Expecter) FindUnconverted(ctx interface{}, filter interface{}) *mockanalyticsRepository_FindUnconverted_Call {
+	return &mockanalyticsRepository_FindUnconverted_Call{Call: _e.mock.On("FindUnconverted", ctx, filter)}
+}
+
+func (_c *mockanalyticsRepository_FindUnconverted_Call) Run(run func(ctx context.Context, filter domain.AnalyticsFilter)) *mockanalyticsRepository_FindUnconverted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockanalyticsRepository_FindUnconverted_Call) Return(unconvertedItems []domain.UnconvertedItem, err error) *mockanalyticsRepository_FindUnconverted_Call {
+	_c.Call.Return(unconvertedItems, err)
+	return _c
+}
+
+func (_c *mockanalyticsRepository_FindUnconverted_Call) RunAndReturn(run func(ctx context.Context, filter domain.AnalyticsFilter) ([]domain.UnconvertedItem, error)) *mockanalyticsRepository_FindUnconverted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockattachmentRepository creates a new instance of mockattachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockattachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockattachmentRepository {
+	mock := &mockattachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockattachmentRepository is an autogenerated mock type for the attachmentRepository type
+type mockattachmentRepository struct {
+	mock.Mock
+}
+
+type mockattachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockattachmentRepository) EXPECT() *mockattachmentRepository_Expecter {
+	return &mockattachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mockattachmentRepository
+func (_mock *mockattachmentRepository) Create(ctx context.Context, a domain.Attachment) (domain.Attachment, error) {
+	ret := _mock.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Attachment) (domain.Attachment, error)); ok {
+		return returnFunc(ctx, a)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Attachment) domain.Attachment); ok {
+		r0 = returnFunc(ctx, a)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Attachment) error); ok {
+		r1 = returnFunc(ctx, a)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockattachmentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockattachmentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a domain.Attachment
+func (_e *mockattachmentRepository_Expecter) Create(ctx interface{}, a interface{}) *mockattachmentRepository_Create_Call {
+	return &mockattachmentRepository_Create_Call{Call: _e.mock.On("Create", ctx, a)}
+}
+
+func (_c *mockattachmentRepository_Create_Call) Run(run func(ctx context.Context, a domain.Attachment)) *mockattachmentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Attachment
+		if args[1] != nil {
+			arg1 = args[1].(domain.Attachment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentRepository_Create_Call) Return(attachment domain.Attachment, err error) *mockattachmentRepository_Create_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *mockattachmentRepository_Create_Call) RunAndReturn(run func(ctx context.Context, a domain.Attachment) (domain.Attachment, error)) *mockattachmentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockattachmentRepository
+func (_mock *mockattachmentRepository) Delete(ctx context.Context, itemID string, id string) (domain.Attachment, error) {
+	ret := _mock.Called(ctx, itemID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Attachment, error)); ok {
+		return returnFunc(ctx, itemID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Attachment); ok {
+		r0 = returnFunc(ctx, itemID, id)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, itemID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockattachmentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockattachmentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - id string
+func (_e *mockattachmentRepository_Expecter) Delete(ctx interface{}, itemID interface{}, id interface{}) *mockattachmentRepository_Delete_Call {
+	return &mockattachmentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, itemID, id)}
+}
+
+func (_c *mockattachmentRepository_Delete_Call) Run(run func(ctx context.Context, itemID string, id string)) *mockattachmentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentRepository_Delete_Call) Return(attachment domain.Attachment, err error) *mockattachmentRepository_Delete_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *mockattachmentRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, itemID string, id string) (domain.Attachment, error)) *mockattachmentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockattachmentRepository
+func (_mock *mockattachmentRepository) GetByID(ctx context.Context, itemID string, id string) (domain.Attachment, error) {
+	ret := _mock.Called(ctx, itemID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domain.Attachment, error)); ok {
+		return returnFunc(ctx, itemID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domain.Attachment); ok {
+		r0 = returnFunc(ctx, itemID, id)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, itemID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockattachmentRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockattachmentRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - id string
+func (_e *mockattachmentRepository_Expecter) GetByID(ctx interface{}, itemID interface{}, id interface{}) *mockattachmentRepository_GetByID_Call {
+	return &mockattachmentRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, itemID, id)}
+}
+
+func (_c *mockattachmentRepository_GetByID_Call) Run(run func(ctx context.Context, itemID string, id string)) *mockattachmentRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentRepository_GetByID_Call) Return(attachment domain.Attachment, err error) *mockattachmentRepository_GetByID_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *mockattachmentRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, itemID string, id string) (domain.Attachment, error)) *mockattachmentRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type mockattachmentRepository
+func (_mock *mockattachmentRepository) List(ctx context.Context, itemID string) ([]domain.Attachment, error) {
+	ret := _mock.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Attachment, error)); ok {
+		return returnFunc(ctx, itemID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Attachment); ok {
+		r0 = returnFunc(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockattachmentRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type mockattachmentRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *mockattachmentRepository_Expecter) List(ctx interface{}, itemID interface{}) *mockattachmentRepository_List_Call {
+	return &mockattachmentRepository_List_Call{Call: _e.mock.On("List", ctx, itemID)}
+}
+
+func (_c *mockattachmentRepository_List_Call) Run(run func(ctx context.Context, itemID string)) *mockattachmentRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockattachmentRepository_List_Call) Return(attachments []domain.Attachment, err error) *mockattachmentRepository_List_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *mockattachmentRepository_List_Call) RunAndReturn(run func(ctx context.Context, itemID string) ([]domain.Attachment, error)) *mockattachmentRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockblobRemover creates a new instance of mockblobRemover. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockblobRemover(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockblobRemover {
+	mock := &mockblobRemover{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockblobRemover is an autogenerated mock type for the blobRemover type
+type mockblobRemover struct {
+	mock.Mock
+}
+
+type mockblobRemover_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockblobRemover) EXPECT() *mockblobRemover_Expecter {
+	return &mockblobRemover_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type mockblobRemover
+func (_mock *mockblobRemover) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockblobRemover_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockblobRemover_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *mockblobRemover_Expecter) Delete(ctx interface{}, key interface{}) *mockblobRemover_Delete_Call {
+	return &mockblobRemover_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *mockblobRemover_Delete_Call) Run(run func(ctx context.Context, key string)) *mockblobRemover_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockblobRemover_Delete_Call) Return(err error) *mockblobRemover_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockblobRemover_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *mockblobRemover_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockblobStorage creates a new instance of mockblobStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockblobStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockblobStorage {
+	mock := &mockblobStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockblobStorage is an autogenerated mock type for the blobStorage type
+type mockblobStorage struct {
+	mock.Mock
+}
+
+type mockblobStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockblobStorage) EXPECT() *mockblobStorage_Expecter {
+	return &mockblobStorage_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type mockblobStorage
+func (_mock *mockblobStorage) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockblobStorage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockblobStorage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *mockblobStorage_Expecter) Delete(ctx interface{}, key interface{}) *mockblobStorage_Delete_Call {
+	return &mockblobStorage_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *mockblobStorage_Delete_Call) Run(run func(ctx context.Context, key string)) *mockblobStorage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockblobStorage_Delete_Call) Return(err error) *mockblobStorage_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockblobStorage_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *mockblobStorage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function for the type mockblobStorage
+func (_mock *mockblobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockblobStorage_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type mockblobStorage_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *mockblobStorage_Expecter) Open(ctx interface{}, key interface{}) *mockblobStorage_Open_Call {
+	return &mockblobStorage_Open_Call{Call: _e.mock.On("Open", ctx, key)}
+}
+
+func (_c *mockblobStorage_Open_Call) Run(run func(ctx context.Context, key string)) *mockblobStorage_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockblobStorage_Open_Call) Return(readCloser io.ReadCloser, err error) *mockblobStorage_Open_Call {
+	_c.Call.Return(readCloser, err)
+	return _c
+}
+
+func (_c *mockblobStorage_Open_Call) RunAndReturn(run func(ctx context.Context, key string) (io.ReadCloser, error)) *mockblobStorage_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function for the type mockblobStorage
+func (_mock *mockblobStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	ret := _mock.Called(ctx, key, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, io.Reader) error); ok {
+		r0 = returnFunc(ctx, key, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockblobStorage_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type mockblobStorage_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - r io.Reader
+func (_e *mockblobStorage_Expecter) Put(ctx interface{}, key interface{}, r interface{}) *mockblobStorage_Put_Call {
+	return &mockblobStorage_Put_Call{Call: _e.mock.On("Put", ctx, key, r)}
+}
+
+func (_c *mockblobStorage_Put_Call) Run(run func(ctx context.Context, key string, r io.Reader)) *mockblobStorage_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockblobStorage_Put_Call) Return(err error) *mockblobStorage_Put_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockblobStorage_Put_Call) RunAndReturn(run func(ctx context.Context, key string, r io.Reader) error) *mockblobStorage_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockcategoryLookup creates a new instance of mockcategoryLookup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockcategoryLookup(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockcategoryLookup {
+	mock := &mockcategoryLookup{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockcategoryLookup is an autogenerated mock type for the categoryLookup type
+type mockcategoryLookup struct {
+	mock.Mock
+}
+
+type mockcategoryLookup_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockcategoryLookup) EXPECT() *mockcategoryLookup_Expecter {
+	return &mockcategoryLookup_Expecter{mock: &_m.Mock}
+}
+
+// GetAll provides a mock function for the type mockcategoryLookup
+func (_mock *mockcategoryLookup) GetAll(ctx context.Context, includeArchived bool) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, includeArchived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, includeArchived)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []domain.Category); ok {
+		r0 = returnFunc(ctx, includeArchived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeArchived)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryLookup_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type mockcategoryLookup_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeArchived bool
+func (_e *mockcategoryLookup_Expecter) GetAll(ctx interface{}, includeArchived interface{}) *mockcategoryLookup_GetAll_Call {
+	return &mockcategoryLookup_GetAll_Call{Call: _e.mock.On("GetAll", ctx, includeArchived)}
+}
+
+func (_c *mockcategoryLookup_GetAll_Call) Run(run func(ctx context.Context, includeArchived bool)) *mockcategoryLookup_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryLookup_GetAll_Call) Return(categorys []domain.Category, err error) *mockcategoryLookup_GetAll_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *mockcategoryLookup_GetAll_Call) RunAndReturn(run func(ctx context.Context, includeArchived bool) ([]domain.Category, error)) *mockcategoryLookup_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function for the type mockcategoryLookup
+func (_mock *mockcategoryLookup) GetByName(ctx context.Context, name string) (domain.Category, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Category, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Category); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryLookup_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type mockcategoryLookup_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *mockcategoryLookup_Expecter) GetByName(ctx interface{}, name interface{}) *mockcategoryLookup_GetByName_Call {
+	return &mockcategoryLookup_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *mockcategoryLookup_GetByName_Call) Run(run func(ctx context.Context, name string)) *mockcategoryLookup_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryLookup_GetByName_Call) Return(category domain.Category, err error) *mockcategoryLookup_GetByName_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryLookup_GetByName_Call) RunAndReturn(run func(ctx context.Context, name string) (domain.Category, error)) *mockcategoryLookup_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockcategoryRepository creates a new instance of mockcategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockcategoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockcategoryRepository {
+	mock := &mockcategoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockcategoryRepository is an autogenerated mock type for the categoryRepository type
+type mockcategoryRepository struct {
+	mock.Mock
+}
+
+type mockcategoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockcategoryRepository) EXPECT() *mockcategoryRepository_Expecter {
+	return &mockcategoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mockcategoryRepository
+func (_mock *mockcategoryRepository) Create(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	ret := _mock.Called(ctx, cat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) (domain.Category, error)); ok {
+		return returnFunc(ctx, cat)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) domain.Category); ok {
+		r0 = returnFunc(ctx, cat)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Category) error); ok {
+		r1 = returnFunc(ctx, cat)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockcategoryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cat domain.Category
+func (_e *mockcategoryRepository_Expecter) Create(ctx interface{}, cat interface{}) *mockcategoryRepository_Create_Call {
+	return &mockcategoryRepository_Create_Call{Call: _e.mock.On("Create", ctx, cat)}
+}
+
+func (_c *mockcategoryRepository_Create_Call) Run(run func(ctx context.Context, cat domain.Category)) *mockcategoryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryRepository_Create_Call) Return(category domain.Category, err error) *mockcategoryRepository_Create_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, cat domain.Category) (domain.Category, error)) *mockcategoryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockcategoryRepository
+func (_mock *mockcategoryRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockcategoryRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockcategoryRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockcategoryRepository_Expecter) Delete(ctx interface{}, id interface{}) *mockcategoryRepository_Delete_Call {
+	return &mockcategoryRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockcategoryRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mockcategoryRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryRepository_Delete_Call) Return(err error) *mockcategoryRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockcategoryRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockcategoryRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type mockcategoryRepository
+func (_mock *mockcategoryRepository) GetAll(ctx context.Context, includeArchived bool) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, includeArchived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, includeArchived)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []domain.Category); ok {
+		r0 = returnFunc(ctx, includeArchived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeArchived)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type mockcategoryRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeArchived bool
+func (_e *mockcategoryRepository_Expecter) GetAll(ctx interface{}, includeArchived interface{}) *mockcategoryRepository_GetAll_Call {
+	return &mockcategoryRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, includeArchived)}
+}
+
+func (_c *mockcategoryRepository_GetAll_Call) Run(run func(ctx context.Context, includeArchived bool)) *mockcategoryRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryRepository_GetAll_Call) Return(categorys []domain.Category, err error) *mockcategoryRepository_GetAll_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *mockcategoryRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, includeArchived bool) ([]domain.Category, error)) *mockcategoryRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockcategoryRepository
+func (_mock *mockcategoryRepository) GetByID(ctx context.Context, id string) (domain.Category, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Category, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Category); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockcategoryRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockcategoryRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mockcategoryRepository_GetByID_Call {
+	return &mockcategoryRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockcategoryRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockcategoryRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryRepository_GetByID_Call) Return(category domain.Category, err error) *mockcategoryRepository_GetByID_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Category, error)) *mockcategoryRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InUse provides a mock function for the type mockcategoryRepository
+func (_mock *mockcategoryRepository) InUse(ctx context.Context, name string, otherThanType string) (bool, error) {
+	ret := _mock.Called(ctx, name, otherThanType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InUse")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, name, otherThanType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, name, otherThanType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, name, otherThanType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryRepository_InUse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InUse'
+type mockcategoryRepository_InUse_Call struct {
+	*mock.Call
+}
+
+// InUse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - otherThanType string
+func (_e *mockcategoryRepository_Expecter) InUse(ctx interface{}, name interface{}, otherThanType interface{}) *mockcategoryRepository_InUse_Call {
+	return &mockcategoryRepository_InUse_Call{Call: _e.mock.On("InUse", ctx, name, otherThanType)}
+}
+
+func (_c *mockcategoryRepository_InUse_Call) Run(run func(ctx context.Context, name string, otherThanType string)) *mockcategoryRepository_InUse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryRepository_InUse_Call) Return(b bool, err error) *mockcategoryRepository_InUse_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *mockcategoryRepository_InUse_Call) RunAndReturn(run func(ctx context.Context, name string, otherThanType string) (bool, error)) *mockcategoryRepository_InUse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockcategoryRepository
+func (_mock *mockcategoryRepository) Update(ctx context.Context, cat domain.Category) (domain.Category, error) {
+	ret := _mock.Called(ctx, cat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) (domain.Category, error)); ok {
+		return returnFunc(ctx, cat)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Category) domain.Category); ok {
+		r0 = returnFunc(ctx, cat)
+	} else {
+		r0 = ret.Get(0).(domain.Category)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Category) error); ok {
+		r1 = returnFunc(ctx, cat)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockcategoryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockcategoryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cat domain.Category
+func (_e *mockcategoryRepository_Expecter) Update(ctx interface{}, cat interface{}) *mockcategoryRepository_Update_Call {
+	return &mockcategoryRepository_Update_Call{Call: _e.mock.On("Update", ctx, cat)}
+}
+
+func (_c *mockcategoryRepository_Update_Call) Run(run func(ctx context.Context, cat domain.Category)) *mockcategoryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcategoryRepository_Update_Call) Return(category domain.Category, err error) *mockcategoryRepository_Update_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *mockcategoryRepository_Update_Call) RunAndReturn(run func(ctx context.Context, cat domain.Category) (domain.Category, error)) *mockcategoryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockitemCreator creates a new instance of mockitemCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockitemCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockitemCreator {
+	mock := &mockitemCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockitemCreator is an autogenerated mock type for the itemCreator type
+type mockitemCreator struct {
+	mock.Mock
+}
+
+type mockitemCreator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockitemCreator) EXPECT() *mockitemCreator_Expecter {
+	return &mockitemCreator_Expecter{mock: &_m.Mock}
+}
+
+// CreateScheduled provides a mock function for the type mockitemCreator
+func (_mock *mockitemCreator) CreateScheduled(ctx context.Context, item domain.Item) (domain.Item, error) {
+	ret := _mock.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateScheduled")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
+		return returnFunc(ctx, item)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
+		r0 = returnFunc(ctx, item)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
+		r1 = returnFunc(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemCreator_CreateScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduled'
+type mockitemCreator_CreateScheduled_Call struct {
+	*mock.Call
+}
+
+// CreateScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item domain.Item
+func (_e *mockitemCreator_Expecter) CreateScheduled(ctx interface{}, item interface{}) *mockitemCreator_CreateScheduled_Call {
+	return &mockitemCreator_CreateScheduled_Call{Call: _e.mock.On("CreateScheduled", ctx, item)}
+}
+
+func (_c *mockitemCreator_CreateScheduled_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemCreator_CreateScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Item
+		if args[1] != nil {
+			arg1 = args[1].(domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemCreator_CreateScheduled_Call) Return(item1 domain.Item, err error) *mockitemCreator_CreateScheduled_Call {
+	_c.Call.Return(item1, err)
+	return _c
+}
+
+func (_c *mockitemCreator_CreateScheduled_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemCreator_CreateScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockitemRepository creates a new instance of mockitemRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockitemRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockitemRepository {
+	mock := &mockitemRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockitemRepository is an autogenerated mock type for the itemRepository type
+type mockitemRepository struct {
+	mock.Mock
+}
+
+type mockitemRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockitemRepository) EXPECT() *mockitemRepository_Expecter {
+	return &mockitemRepository_Expecter{mock: &_m.Mock}
+}
+
+// ApplyBatch provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) ApplyBatch(ctx context.Context, ops []domain.BatchOperation) ([]domain.BatchResult, error) {
+	ret := _mock.Called(ctx, ops)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyBatch")
+	}
+
+	var r0 []domain.BatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.BatchOperation) ([]domain.BatchResult, error)); ok {
+		return returnFunc(ctx, ops)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.BatchOperation) []domain.BatchResult); ok {
+		r0 = returnFunc(ctx, ops)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BatchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.BatchOperation) error); ok {
+		r1 = returnFunc(ctx, ops)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_ApplyBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyBatch'
+type mockitemRepository_ApplyBatch_Call struct {
+	*mock.Call
+}
+
+// ApplyBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ops []domain.BatchOperation
+func (_e *mockitemRepository_Expecter) ApplyBatch(ctx interface{}, ops interface{}) *mockitemRepository_ApplyBatch_Call {
+	return &mockitemRepository_ApplyBatch_Call{Call: _e.mock.On("ApplyBatch", ctx, ops)}
+}
+
+func (_c *mockitemRepository_ApplyBatch_Call) Run(run func(ctx context.Context, ops []domain.BatchOperation)) *mockitemRepository_ApplyBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.BatchOperation
+		if args[1] != nil {
+			arg1 = args[1].([]domain.BatchOperation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_ApplyBatch_Call) Return(batchResults []domain.BatchResult, err error) *mockitemRepository_ApplyBatch_Call {
+	_c.Call.Return(batchResults, err)
+	return _c
+}
+
+func (_c *mockitemRepository_ApplyBatch_Call) RunAndReturn(run func(ctx context.Context, ops []domain.BatchOperation) ([]domain.BatchResult, error)) *mockitemRepository_ApplyBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Create(ctx context.Context, item domain.Item) (domain.Item, error) {
+	ret := _mock.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
+		return returnFunc(ctx, item)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
+		r0 = returnFunc(ctx, item)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
+		r1 = returnFunc(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockitemRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item domain.Item
+func (_e *mockitemRepository_Expecter) Create(ctx interface{}, item interface{}) *mockitemRepository_Create_Call {
+	return &mockitemRepository_Create_Call{Call: _e.mock.On("Create", ctx, item)}
+}
+
+func (_c *mockitemRepository_Create_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Item
+		if args[1] != nil {
+			arg1 = args[1].(domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Create_Call) Return(item1 domain.Item, err error) *mockitemRepository_Create_Call {
+	_c.Call.Return(item1, err)
+	return _c
+}
+
+func (_c *mockitemRepository_Create_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockitemRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockitemRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemRepository_Expecter) Delete(ctx interface{}, id interface{}) *mockitemRepository_Delete_Call {
+	return &mockitemRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockitemRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mockitemRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Delete_Call) Return(err error) *mockitemRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockitemRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockitemRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Exists(ctx context.Context, id string) (bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type mockitemRepository_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemRepository_Expecter) Exists(ctx interface{}, id interface{}) *mockitemRepository_Exists_Call {
+	return &mockitemRepository_Exists_Call{Call: _e.mock.On("Exists", ctx, id)}
+}
+
+func (_c *mockitemRepository_Exists_Call) Run(run func(ctx context.Context, id string)) *mockitemRepository_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Exists_Call) Return(b bool, err error) *mockitemRepository_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *mockitemRepository_Exists_Call) RunAndReturn(run func(ctx context.Context, id string) (bool, error)) *mockitemRepository_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) GetAll(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 domain.ItemPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) (domain.ItemPage, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemFilter) domain.ItemPage); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.ItemPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type mockitemRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.ItemFilter
+func (_e *mockitemRepository_Expecter) GetAll(ctx interface{}, filter interface{}) *mockitemRepository_GetAll_Call {
+	return &mockitemRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, filter)}
+}
+
+func (_c *mockitemRepository_GetAll_Call) Run(run func(ctx context.Context, filter domain.ItemFilter)) *mockitemRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ItemFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.ItemFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_GetAll_Call) Return(itemPage domain.ItemPage, err error) *mockitemRepository_GetAll_Call {
+	_c.Call.Return(itemPage, err)
+	return _c
+}
+
+func (_c *mockitemRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, filter domain.ItemFilter) (domain.ItemPage, error)) *mockitemRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) GetByID(ctx context.Context, id string) (domain.Item, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Item, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Item); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockitemRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mockitemRepository_GetByID_Call {
+	return &mockitemRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockitemRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockitemRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_GetByID_Call) Return(item domain.Item, err error) *mockitemRepository_GetByID_Call {
+	_c.Call.Return(item, err)
+	return _c
+}
+
+func (_c *mockitemRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Item, error)) *mockitemRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) GetHistory(ctx context.Context, itemID string) ([]domain.ItemHistoryEntry, error) {
+	ret := _mock.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []domain.ItemHistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.ItemHistoryEntry, error)); ok {
+		return returnFunc(ctx, itemID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.ItemHistoryEntry); ok {
+		r0 = returnFunc(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ItemHistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type mockitemRepository_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *mockitemRepository_Expecter) GetHistory(ctx interface{}, itemID interface{}) *mockitemRepository_GetHistory_Call {
+	return &mockitemRepository_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, itemID)}
+}
+
+func (_c *mockitemRepository_GetHistory_Call) Run(run func(ctx context.Context, itemID string)) *mockitemRepository_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_GetHistory_Call) Return(itemHistoryEntrys []domain.ItemHistoryEntry, err error) *mockitemRepository_GetHistory_Call {
+	_c.Call.Return(itemHistoryEntrys, err)
+	return _c
+}
+
+func (_c *mockitemRepository_GetHistory_Call) RunAndReturn(run func(ctx context.Context, itemID string) ([]domain.ItemHistoryEntry, error)) *mockitemRepository_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Patch(ctx context.Context, patch domain.ItemPatch) (domain.Item, error) {
+	ret := _mock.Called(ctx, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemPatch) (domain.Item, error)); ok {
+		return returnFunc(ctx, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ItemPatch) domain.Item); ok {
+		r0 = returnFunc(ctx, patch)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ItemPatch) error); ok {
+		r1 = returnFunc(ctx, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type mockitemRepository_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - patch domain.ItemPatch
+func (_e *mockitemRepository_Expecter) Patch(ctx interface{}, patch interface{}) *mockitemRepository_Patch_Call {
+	return &mockitemRepository_Patch_Call{Call: _e.mock.On("Patch", ctx, patch)}
+}
+
+func (_c *mockitemRepository_Patch_Call) Run(run func(ctx context.Context, patch domain.ItemPatch)) *mockitemRepository_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ItemPatch
+		if args[1] != nil {
+			arg1 = args[1].(domain.ItemPatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Patch_Call) Return(item domain.Item, err error) *mockitemRepository_Patch_Call {
+	_c.Call.Return(item, err)
+	return _c
+}
+
+func (_c *mockitemRepository_Patch_Call) RunAndReturn(run func(ctx context.Context, patch domain.ItemPatch) (domain.Item, error)) *mockitemRepository_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Purge(ctx context.Context, olderThan time.Time) (int64, []string, error) {
+	ret := _mock.Called(ctx, olderThan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, []string, error)); ok {
+		return returnFunc(ctx, olderThan)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, olderThan)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) []string); ok {
+		r1 = returnFunc(ctx, olderThan)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = returnFunc(ctx, olderThan)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// mockitemRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type mockitemRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Time
+func (_e *mockitemRepository_Expecter) Purge(ctx interface{}, olderThan interface{}) *mockitemRepository_Purge_Call {
+	return &mockitemRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, olderThan)}
+}
+
+func (_c *mockitemRepository_Purge_Call) Run(run func(ctx context.Context, olderThan time.Time)) *mockitemRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Purge_Call) Return(n int64, ss []string, err error) *mockitemRepository_Purge_Call {
+	_c.Call.Return(n, ss, err)
+	return _c
+}
+
+func (_c *mockitemRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, olderThan time.Time) (int64, []string, error)) *mockitemRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Restore(ctx context.Context, id string) (domain.Item, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Item, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Item); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type mockitemRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockitemRepository_Expecter) Restore(ctx interface{}, id interface{}) *mockitemRepository_Restore_Call {
+	return &mockitemRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *mockitemRepository_Restore_Call) Run(run func(ctx context.Context, id string)) *mockitemRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Restore_Call) Return(item domain.Item, err error) *mockitemRepository_Restore_Call {
+	_c.Call.Return(item, err)
+	return _c
+}
+
+func (_c *mockitemRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Item, error)) *mockitemRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockitemRepository
+func (_mock *mockitemRepository) Update(ctx context.Context, item domain.Item) (domain.Item, error) {
+	ret := _mock.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.Item
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) (domain.Item, error)); ok {
+		return returnFunc(ctx, item)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item) domain.Item); ok {
+		r0 = returnFunc(ctx, item)
+	} else {
+		r0 = ret.Get(0).(domain.Item)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item) error); ok {
+		r1 = returnFunc(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockitemRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockitemRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item domain.Item
+func (_e *mockitemRepository_Expecter) Update(ctx interface{}, item interface{}) *mockitemRepository_Update_Call {
+	return &mockitemRepository_Update_Call{Call: _e.mock.On("Update", ctx, item)}
+}
+
+func (_c *mockitemRepository_Update_Call) Run(run func(ctx context.Context, item domain.Item)) *mockitemRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Item
+		if args[1] != nil {
+			arg1 = args[1].(domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockitemRepository_Update_Call) Return(item1 domain.Item, err error) *mockitemRepository_Update_Call {
+	_c.Call.Return(item1, err)
+	return _c
+}
+
+func (_c *mockitemRepository_Update_Call) RunAndReturn(run func(ctx context.Context, item domain.Item) (domain.Item, error)) *mockitemRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockrateRepository creates a new instance of mockrateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockrateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockrateRepository {
+	mock := &mockrateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockrateRepository is an autogenerated mock type for the rateRepository type
+type mockrateRepository struct {
+	mock.Mock
+}
+
+type mockrateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockrateRepository) EXPECT() *mockrateRepository_Expecter {
+	return &mockrateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mockrateRepository
+func (_mock *mockrateRepository) Create(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) (domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, rate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, rate)
+	} else {
+		r0 = ret.Get(0).(domain.ExchangeRate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ExchangeRate) error); ok {
+		r1 = returnFunc(ctx, rate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockrateRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate domain.ExchangeRate
+func (_e *mockrateRepository_Expecter) Create(ctx interface{}, rate interface{}) *mockrateRepository_Create_Call {
+	return &mockrateRepository_Create_Call{Call: _e.mock.On("Create", ctx, rate)}
+}
+
+func (_c *mockrateRepository_Create_Call) Run(run func(ctx context.Context, rate domain.ExchangeRate)) *mockrateRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateRepository_Create_Call) Return(exchangeRate domain.ExchangeRate, err error) *mockrateRepository_Create_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *mockrateRepository_Create_Call) RunAndReturn(run func(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)) *mockrateRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockrateRepository
+func (_mock *mockrateRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockrateRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockrateRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrateRepository_Expecter) Delete(ctx interface{}, id interface{}) *mockrateRepository_Delete_Call {
+	return &mockrateRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockrateRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mockrateRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateRepository_Delete_Call) Return(err error) *mockrateRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockrateRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockrateRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type mockrateRepository
+func (_mock *mockrateRepository) GetAll(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RateFilter) ([]domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RateFilter) []domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.RateFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type mockrateRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.RateFilter
+func (_e *mockrateRepository_Expecter) GetAll(ctx interface{}, filter interface{}) *mockrateRepository_GetAll_Call {
+	return &mockrateRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, filter)}
+}
+
+func (_c *mockrateRepository_GetAll_Call) Run(run func(ctx context.Context, filter domain.RateFilter)) *mockrateRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.RateFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.RateFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateRepository_GetAll_Call) Return(exchangeRates []domain.ExchangeRate, err error) *mockrateRepository_GetAll_Call {
+	_c.Call.Return(exchangeRates, err)
+	return _c
+}
+
+func (_c *mockrateRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error)) *mockrateRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockrateRepository
+func (_mock *mockrateRepository) GetByID(ctx context.Context, id string) (domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.ExchangeRate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockrateRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrateRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mockrateRepository_GetByID_Call {
+	return &mockrateRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockrateRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockrateRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateRepository_GetByID_Call) Return(exchangeRate domain.ExchangeRate, err error) *mockrateRepository_GetByID_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *mockrateRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.ExchangeRate, error)) *mockrateRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type mockrateRepository
+func (_mock *mockrateRepository) Update(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	ret := _mock.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) (domain.ExchangeRate, error)); ok {
+		return returnFunc(ctx, rate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExchangeRate) domain.ExchangeRate); ok {
+		r0 = returnFunc(ctx, rate)
+	} else {
+		r0 = ret.Get(0).(domain.ExchangeRate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ExchangeRate) error); ok {
+		r1 = returnFunc(ctx, rate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type mockrateRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate domain.ExchangeRate
+func (_e *mockrateRepository_Expecter) Update(ctx interface{}, rate interface{}) *mockrateRepository_Update_Call {
+	return &mockrateRepository_Update_Call{Call: _e.mock.On("Update", ctx, rate)}
+}
+
+func (_c *mockrateRepository_Update_Call) Run(run func(ctx context.Context, rate domain.ExchangeRate)) *mockrateRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateRepository_Update_Call) Return(exchangeRate domain.ExchangeRate, err error) *mockrateRepository_Update_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *mockrateRepository_Update_Call) RunAndReturn(run func(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)) *mockrateRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type mockrateRepository
+func (_mock *mockrateRepository) Upsert(ctx context.Context, rates []domain.ExchangeRate) (int, error) {
+	ret := _mock.Called(ctx, rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ExchangeRate) (int, error)); ok {
+		return returnFunc(ctx, rates)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ExchangeRate) int); ok {
+		r0 = returnFunc(ctx, rates)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.ExchangeRate) error); ok {
+		r1 = returnFunc(ctx, rates)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrateRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type mockrateRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rates []domain.ExchangeRate
+func (_e *mockrateRepository_Expecter) Upsert(ctx interface{}, rates interface{}) *mockrateRepository_Upsert_Call {
+	return &mockrateRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, rates)}
+}
+
+func (_c *mockrateRepository_Upsert_Call) Run(run func(ctx context.Context, rates []domain.ExchangeRate)) *mockrateRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].([]domain.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrateRepository_Upsert_Call) Return(n int, err error) *mockrateRepository_Upsert_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *mockrateRepository_Upsert_Call) RunAndReturn(run func(ctx context.Context, rates []domain.ExchangeRate) (int, error)) *mockrateRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockrecurringRepository creates a new instance of mockrecurringRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockrecurringRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockrecurringRepository {
+	mock := &mockrecurringRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockrecurringRepository is an autogenerated mock type for the recurringRepository type
+type mockrecurringRepository struct {
+	mock.Mock
+}
+
+type mockrecurringRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockrecurringRepository) EXPECT() *mockrecurringRepository_Expecter {
+	return &mockrecurringRepository_Expecter{mock: &_m.Mock}
+}
+
+// Advance provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) Advance(ctx context.Context, id string, fromCount int, toCount int, nextRun *time.Time) (bool, error) {
+	ret := _mock.Called(ctx, id, fromCount, toCount, nextRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Advance")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, *time.Time) (bool, error)); ok {
+		return returnFunc(ctx, id, fromCount, toCount, nextRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, *time.Time) bool); ok {
+		r0 = returnFunc(ctx, id, fromCount, toCount, nextRun)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int, *time.Time) error); ok {
+		r1 = returnFunc(ctx, id, fromCount, toCount, nextRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringRepository_Advance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Advance'
+type mockrecurringRepository_Advance_Call struct {
+	*mock.Call
+}
+
+// Advance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - fromCount int
+//   - toCount int
+//   - nextRun *time.Time
+func (_e *mockrecurringRepository_Expecter) Advance(ctx interface{}, id interface{}, fromCount interface{}, toCount interface{}, nextRun interface{}) *mockrecurringRepository_Advance_Call {
+	return &mockrecurringRepository_Advance_Call{Call: _e.mock.On("Advance", ctx, id, fromCount, toCount, nextRun)}
+}
+
+func (_c *mockrecurringRepository_Advance_Call) Run(run func(ctx context.Context, id string, fromCount int, toCount int, nextRun *time.Time)) *mockrecurringRepository_Advance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 *time.Time
+		if args[4] != nil {
+			arg4 = args[4].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_Advance_Call) Return(b bool, err error) *mockrecurringRepository_Advance_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_Advance_Call) RunAndReturn(run func(ctx context.Context, id string, fromCount int, toCount int, nextRun *time.Time) (bool, error)) *mockrecurringRepository_Advance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) Create(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RecurringItem) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RecurringItem) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.RecurringItem) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mockrecurringRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec domain.RecurringItem
+func (_e *mockrecurringRepository_Expecter) Create(ctx interface{}, rec interface{}) *mockrecurringRepository_Create_Call {
+	return &mockrecurringRepository_Create_Call{Call: _e.mock.On("Create", ctx, rec)}
+}
+
+func (_c *mockrecurringRepository_Create_Call) Run(run func(ctx context.Context, rec domain.RecurringItem)) *mockrecurringRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.RecurringItem
+		if args[1] != nil {
+			arg1 = args[1].(domain.RecurringItem)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_Create_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringRepository_Create_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_Create_Call) RunAndReturn(run func(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error)) *mockrecurringRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockrecurringRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockrecurringRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrecurringRepository_Expecter) Delete(ctx interface{}, id interface{}) *mockrecurringRepository_Delete_Call {
+	return &mockrecurringRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mockrecurringRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mockrecurringRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_Delete_Call) Return(err error) *mockrecurringRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mockrecurringRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) GetAll(ctx context.Context) ([]domain.RecurringItem, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.RecurringItem, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.RecurringItem); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RecurringItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type mockrecurringRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockrecurringRepository_Expecter) GetAll(ctx interface{}) *mockrecurringRepository_GetAll_Call {
+	return &mockrecurringRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *mockrecurringRepository_GetAll_Call) Run(run func(ctx context.Context)) *mockrecurringRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_GetAll_Call) Return(recurringItems []domain.RecurringItem, err error) *mockrecurringRepository_GetAll_Call {
+	_c.Call.Return(recurringItems, err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.RecurringItem, error)) *mockrecurringRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) GetByID(ctx context.Context, id string) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mockrecurringRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mockrecurringRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mockrecurringRepository_GetByID_Call {
+	return &mockrecurringRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mockrecurringRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mockrecurringRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_GetByID_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringRepository_GetByID_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.RecurringItem, error)) *mockrecurringRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDue provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) ListDue(ctx context.Context, today time.Time) ([]domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, today)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDue")
+	}
+
+	var r0 []domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, today)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, today)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RecurringItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, today)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringRepository_ListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDue'
+type mockrecurringRepository_ListDue_Call struct {
+	*mock.Call
+}
+
+// ListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - today time.Time
+func (_e *mockrecurringRepository_Expecter) ListDue(ctx interface{}, today interface{}) *mockrecurringRepository_ListDue_Call {
+	return &mockrecurringRepository_ListDue_Call{Call: _e.mock.On("ListDue", ctx, today)}
+}
+
+func (_c *mockrecurringRepository_ListDue_Call) Run(run func(ctx context.Context, today time.Time)) *mockrecurringRepository_ListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_ListDue_Call) Return(recurringItems []domain.RecurringItem, err error) *mockrecurringRepository_ListDue_Call {
+	_c.Call.Return(recurringItems, err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_ListDue_Call) RunAndReturn(run func(ctx context.Context, today time.Time) ([]domain.RecurringItem, error)) *mockrecurringRepository_ListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchedule provides a mock function for the type mockrecurringRepository
+func (_mock *mockrecurringRepository) UpdateSchedule(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchedule")
+	}
+
+	var r0 domain.RecurringItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RecurringItem) (domain.RecurringItem, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RecurringItem) domain.RecurringItem); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		r0 = ret.Get(0).(domain.RecurringItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.RecurringItem) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockrecurringRepository_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type mockrecurringRepository_UpdateSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec domain.RecurringItem
+func (_e *mockrecurringRepository_Expecter) UpdateSchedule(ctx interface{}, rec interface{}) *mockrecurringRepository_UpdateSchedule_Call {
+	return &mockrecurringRepository_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", ctx, rec)}
+}
+
+func (_c *mockrecurringRepository_UpdateSchedule_Call) Run(run func(ctx context.Context, rec domain.RecurringItem)) *mockrecurringRepository_UpdateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.RecurringItem
+		if args[1] != nil {
+			arg1 = args[1].(domain.RecurringItem)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockrecurringRepository_UpdateSchedule_Call) Return(recurringItem domain.RecurringItem, err error) *mockrecurringRepository_UpdateSchedule_Call {
+	_c.Call.Return(recurringItem, err)
+	return _c
+}
+
+func (_c *mockrecurringRepository_UpdateSchedule_Call) RunAndReturn(run func(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error)) *mockrecurringRepository_UpdateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMocktransferRepository creates a new instance of mocktransferRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMocktransferRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocktransferRepository {
+	mock := &mocktransferRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mocktransferRepository is an autogenerated mock type for the transferRepository type
+type mocktransferRepository struct {
+	mock.Mock
+}
+
+type mocktransferRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mocktransferRepository) EXPECT() *mocktransferRepository_Expecter {
+	return &mocktransferRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type mocktransferRepository
+func (_mock *mocktransferRepository) Create(ctx context.Context, from domain.Item, to domain.Item) (domain.Transfer, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item, domain.Item) (domain.Transfer, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Item, domain.Item) domain.Transfer); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(domain.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Item, domain.Item) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocktransferRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type mocktransferRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from domain.Item
+//   - to domain.Item
+func (_e *mocktransferRepository_Expecter) Create(ctx interface{}, from interface{}, to interface{}) *mocktransferRepository_Create_Call {
+	return &mocktransferRepository_Create_Call{Call: _e.mock.On("Create", ctx, from, to)}
+}
+
+func (_c *mocktransferRepository_Create_Call) Run(run func(ctx context.Context, from domain.Item, to domain.Item)) *mocktransferRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Item
+		if args[1] != nil {
+			arg1 = args[1].(domain.Item)
+		}
+		var arg2 domain.Item
+		if args[2] != nil {
+			arg2 = args[2].(domain.Item)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *mocktransferRepository_Create_Call) Return(transfer domain.Transfer, err error) *mocktransferRepository_Create_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *mocktransferRepository_Create_Call) RunAndReturn(run func(ctx context.Context, from domain.Item, to domain.Item) (domain.Transfer, error)) *mocktransferRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type mocktransferRepository
+func (_mock *mocktransferRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mocktransferRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mocktransferRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mocktransferRepository_Expecter) Delete(ctx interface{}, id interface{}) *mocktransferRepository_Delete_Call {
+	return &mocktransferRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *mocktransferRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *mocktransferRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mocktransferRepository_Delete_Call) Return(err error) *mocktransferRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mocktransferRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *mocktransferRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type mocktransferRepository
+func (_mock *mocktransferRepository) GetByID(ctx context.Context, id string) (domain.Transfer, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.Transfer, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.Transfer); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocktransferRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type mocktransferRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *mocktransferRepository_Expecter) GetByID(ctx interface{}, id interface{}) *mocktransferRepository_GetByID_Call {
+	return &mocktransferRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *mocktransferRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *mocktransferRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mocktransferRepository_GetByID_Call) Return(transfer domain.Transfer, err error) *mocktransferRepository_GetByID_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *mocktransferRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (domain.Transfer, error)) *mocktransferRepository_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
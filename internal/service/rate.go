@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/helpers"
+)
+
+type rateRepository interface {
+	Create(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)
+	GetAll(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error)
+	GetByID(ctx context.Context, id string) (domain.ExchangeRate, error)
+	Update(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)
+	Delete(ctx context.Context, id string) error
+	Upsert(ctx context.Context, rates []domain.ExchangeRate) (int, error)
+}
+
+type RateService struct {
+	repo rateRepository
+}
+
+func NewRateService(repo rateRepository) *RateService {
+	return &RateService{repo: repo}
+}
+
+func (s *RateService) Create(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	created, err := s.repo.Create(ctx, rate)
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+	return created, nil
+}
+
+func (s *RateService) List(ctx context.Context, filter domain.RateFilter) ([]domain.ExchangeRate, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("validate filter: %w", err)
+	}
+
+	rates, err := s.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (s *RateService) GetByID(ctx context.Context, id string) (domain.ExchangeRate, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.ExchangeRate{}, domain.ErrInvalidID
+	}
+
+	rate, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+func (s *RateService) Update(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	if err := helpers.ParseUUID(rate.ID); err != nil {
+		return domain.ExchangeRate{}, domain.ErrInvalidID
+	}
+
+	updated, err := s.repo.Update(ctx, rate)
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+	return updated, nil
+}
+
+func (s *RateService) Delete(ctx context.Context, id string) error {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.ErrInvalidID
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Import сохраняет курсы пачкой; пустой список — no-op.
+func (s *RateService) Import(ctx context.Context, rates []domain.ExchangeRate) (int, error) {
+	if len(rates) == 0 {
+		return 0, nil
+	}
+
+	n, err := s.repo.Upsert(ctx, rates)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
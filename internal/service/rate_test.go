@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestRate() domain.ExchangeRate {
+	return domain.ExchangeRate{
+		ID:   validUUID,
+		Date: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		From: "USD",
+		To:   "RUB",
+		Rate: decimal.RequireFromString("90.5"),
+	}
+}
+
+func TestRateService_Create_Success(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	input := newTestRate()
+	input.ID = ""
+	expected := newTestRate()
+
+	repo.EXPECT().Create(mock.Anything, input).Return(expected, nil)
+
+	result, err := svc.Create(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.ID, result.ID)
+}
+
+func TestRateService_List_InvalidFilter(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	_, err := svc.List(context.Background(), domain.RateFilter{FromCurrency: "dollar"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCurrency)
+}
+
+func TestRateService_List_Success(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	filter := domain.RateFilter{FromCurrency: "USD"}
+	repo.EXPECT().GetAll(mock.Anything, filter).Return([]domain.ExchangeRate{newTestRate()}, nil)
+
+	rates, err := svc.List(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Len(t, rates, 1)
+}
+
+func TestRateService_GetByID_InvalidUUID(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	_, err := svc.GetByID(context.Background(), "bad-id")
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestRateService_Update_NotFound(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	input := newTestRate()
+	repo.EXPECT().Update(mock.Anything, input).Return(domain.ExchangeRate{}, domain.ErrRateNotFound)
+
+	_, err := svc.Update(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrRateNotFound)
+}
+
+func TestRateService_Delete_InvalidUUID(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	err := svc.Delete(context.Background(), "bad-id")
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestRateService_Import_Empty(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	n, err := svc.Import(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestRateService_Import_RepoError(t *testing.T) {
+	repo := newMockrateRepository(t)
+	svc := NewRateService(repo)
+
+	rates := []domain.ExchangeRate{newTestRate()}
+	dbErr := errors.New("tx failed")
+	repo.EXPECT().Upsert(mock.Anything, rates).Return(0, dbErr)
+
+	_, err := svc.Import(context.Background(), rates)
+	assert.ErrorIs(t, err, dbErr)
+}
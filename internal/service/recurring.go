@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/helpers"
+)
+
+// maxPreviewCount — предел для предпросмотра повторений.
+const maxPreviewCount = 100
+
+type recurringRepository interface {
+	Create(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error)
+	GetByID(ctx context.Context, id string) (domain.RecurringItem, error)
+	GetAll(ctx context.Context) ([]domain.RecurringItem, error)
+	ListDue(ctx context.Context, today time.Time) ([]domain.RecurringItem, error)
+	UpdateSchedule(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error)
+	Advance(ctx context.Context, id string, fromCount, toCount int, nextRun *time.Time) (bool, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type itemCreator interface {
+	CreateScheduled(ctx context.Context, item domain.Item) (domain.Item, error)
+}
+
+type RecurringService struct {
+	repo       recurringRepository
+	items      itemCreator
+	categories categoryLookup
+}
+
+// NewRecurringService создаёт повторения через items.CreateScheduled: категории и разбивка
+// проверяются как у обычных записей, но архивная категория серию не останавливает.
+func NewRecurringService(repo recurringRepository, items itemCreator, categories categoryLookup) *RecurringService {
+	return &RecurringService{
+		repo:       repo,
+		items:      items,
+		categories: categories,
+	}
+}
+
+func (s *RecurringService) Create(ctx context.Context, rec domain.RecurringItem) (domain.RecurringItem, error) {
+	var err error
+	if rec.Category, err = lookupCategory(ctx, s.categories, rec.Category, rec.Type, false); err != nil {
+		return domain.RecurringItem{}, err
+	}
+
+	rec.OccurrenceCount = 0
+	rec.SkipTo(rec.StartDate)
+
+	created, err := s.repo.Create(ctx, rec)
+	if err != nil {
+		return domain.RecurringItem{}, err
+	}
+	return created, nil
+}
+
+func (s *RecurringService) List(ctx context.Context) ([]domain.RecurringItem, error) {
+	recs, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return recs, nil
+}
+
+func (s *RecurringService) GetByID(ctx context.Context, id string) (domain.RecurringItem, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.RecurringItem{}, domain.ErrInvalidID
+	}
+
+	rec, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.RecurringItem{}, err
+	}
+	return rec, nil
+}
+
+// Pause останавливает серию: пока она на паузе, повторения не создаются.
+func (s *RecurringService) Pause(ctx context.Context, id string) (domain.RecurringItem, error) {
+	rec, err := s.GetByID(ctx, id)
+	if err != nil {
+		return domain.RecurringItem{}, err
+	}
+
+	rec.Paused = true
+	return s.repo.UpdateSchedule(ctx, rec)
+}
+
+// Resume возобновляет серию с ближайшего повторения не раньше now: пропущенные за время
+// паузы повторения не создаются задним числом.
+func (s *RecurringService) Resume(ctx context.Context, id string, now time.Time) (domain.RecurringItem, error) {
+	rec, err := s.GetByID(ctx, id)
+	if err != nil {
+		return domain.RecurringItem{}, err
+	}
+
+	if rec.Paused {
+		rec.Paused = false
+		rec.SkipTo(truncateToDate(now))
+	}
+	return s.repo.UpdateSchedule(ctx, rec)
+}
+
+func (s *RecurringService) Delete(ctx context.Context, id string) error {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.ErrInvalidID
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// Preview возвращает даты следующих count повторений.
+func (s *RecurringService) Preview(ctx context.Context, id string, count int) ([]time.Time, error) {
+	if count < 1 || count > maxPreviewCount {
+		return nil, domain.ErrInvalidPreviewCount
+	}
+
+	rec, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return rec.Upcoming(count), nil
+}
+
+// RunDue создаёт записи для всех наступивших к now повторений. Запись повторения имеет
+// детерминированный ID, поэтому повторный запуск (после сбоя или вторым экземпляром)
+// получает ErrItemExists и просто сдвигает серию дальше. Ошибки отдельных серий не
+// прерывают обработку остальных и возвращаются вместе.
+func (s *RecurringService) RunDue(ctx context.Context, now time.Time) (int, error) {
+	today := truncateToDate(now)
+
+	recs, err := s.repo.ListDue(ctx, today)
+	if err != nil {
+		return 0, fmt.Errorf("list due recurring items: %w", err)
+	}
+
+	var (
+		created int
+		errs    []error
+	)
+	for _, rec := range recs {
+		n, err := s.materialize(ctx, rec, today)
+		created += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurring item %s: %w", rec.ID, err))
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+func (s *RecurringService) materialize(ctx context.Context, rec domain.RecurringItem, today time.Time) (int, error) {
+	var (
+		created  int
+		count    = rec.OccurrenceCount
+		nextRun  *time.Time
+		runError error
+	)
+	for {
+		date, ok := rec.Occurrence(count)
+		if !ok {
+			break
+		}
+		if date.After(today) {
+			nextRun = &date
+			break
+		}
+
+		if _, err := s.items.CreateScheduled(ctx, rec.ItemFor(date)); err != nil {
+			if !errors.Is(err, domain.ErrItemExists) {
+				nextRun = &date
+				runError = err
+				break
+			}
+		} else {
+			created++
+		}
+		count++
+	}
+
+	if count == rec.OccurrenceCount {
+		return created, runError
+	}
+	if _, err := s.repo.Advance(ctx, rec.ID, rec.OccurrenceCount, count, nextRun); err != nil {
+		return created, errors.Join(runError, err)
+	}
+	return created, runError
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
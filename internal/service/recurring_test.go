@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newTestRecurring() domain.RecurringItem {
+	return domain.RecurringItem{
+		ID:        validUUID,
+		Type:      domain.TypeExpense,
+		Amount:    decimal.NewFromInt(1500),
+		Currency:  "RUB",
+		Category:  "rent",
+		Frequency: domain.FrequencyMonthly,
+		Interval:  1,
+		StartDate: date(2026, 1, 31),
+	}
+}
+
+func TestRecurringItem_Upcoming_MonthlyClampsToMonthEnd(t *testing.T) {
+	rec := newTestRecurring()
+
+	dates := rec.Upcoming(4)
+	assert.Equal(t, []time.Time{
+		date(2026, 1, 31), date(2026, 2, 28), date(2026, 3, 31), date(2026, 4, 30),
+	}, dates)
+}
+
+func TestRecurringItem_Upcoming_StopsAtEndDate(t *testing.T) {
+	rec := newTestRecurring()
+	rec.Frequency = domain.FrequencyWeekly
+	rec.Interval = 2
+	rec.StartDate = date(2026, 3, 2)
+	end := date(2026, 3, 31)
+	rec.EndDate = &end
+
+	dates := rec.Upcoming(10)
+	assert.Equal(t, []time.Time{date(2026, 3, 2), date(2026, 3, 16), date(2026, 3, 30)}, dates)
+}
+
+func TestRecurringItem_ItemFor_Deterministic(t *testing.T) {
+	rec := newTestRecurring()
+
+	a := rec.ItemFor(date(2026, 2, 28))
+	b := rec.ItemFor(date(2026, 2, 28))
+	c := rec.ItemFor(date(2026, 3, 31))
+	assert.Equal(t, a.ID, b.ID)
+	assert.NotEqual(t, a.ID, c.ID)
+	assert.Equal(t, "rent", a.Category)
+}
+
+func TestRecurringService_Create_SetsNextRun(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	svc := NewRecurringService(repo, newMockitemCreator(t), newTestCategories(t))
+
+	input := newTestRecurring()
+	input.ID = ""
+	repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r domain.RecurringItem) bool {
+		return r.OccurrenceCount == 0 && r.NextRun != nil && r.NextRun.Equal(date(2026, 1, 31))
+	})).Return(newTestRecurring(), nil)
+
+	_, err := svc.Create(context.Background(), input)
+	assert.NoError(t, err)
+}
+
+func TestRecurringService_Create_UnknownCategory(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	svc := NewRecurringService(repo, newMockitemCreator(t), newTestCategories(t))
+
+	input := newTestRecurring()
+	input.Category = "misc"
+
+	_, err := svc.Create(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrUnknownCategory)
+}
+
+func TestRecurringService_RunDue_CreatesMissedOccurrences(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	items := newMockitemCreator(t)
+	svc := NewRecurringService(repo, items, newTestCategories(t))
+
+	rec := newTestRecurring()
+	repo.EXPECT().ListDue(mock.Anything, date(2026, 3, 15)).Return([]domain.RecurringItem{rec}, nil)
+	items.EXPECT().CreateScheduled(mock.Anything, mock.MatchedBy(func(i domain.Item) bool {
+		return i.Date.Equal(date(2026, 1, 31))
+	})).Return(domain.Item{}, nil)
+	items.EXPECT().CreateScheduled(mock.Anything, mock.MatchedBy(func(i domain.Item) bool {
+		return i.Date.Equal(date(2026, 2, 28))
+	})).Return(domain.Item{}, nil)
+
+	next := date(2026, 3, 31)
+	repo.EXPECT().Advance(mock.Anything, validUUID, 0, 2, &next).Return(true, nil)
+
+	created, err := svc.RunDue(context.Background(), time.Date(2026, 3, 15, 10, 30, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created)
+}
+
+func TestRecurringService_RunDue_ExistingItemIsNotDuplicated(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	items := newMockitemCreator(t)
+	svc := NewRecurringService(repo, items, newTestCategories(t))
+
+	rec := newTestRecurring()
+	repo.EXPECT().ListDue(mock.Anything, date(2026, 2, 1)).Return([]domain.RecurringItem{rec}, nil)
+	items.EXPECT().CreateScheduled(mock.Anything, mock.Anything).Return(domain.Item{}, domain.ErrItemExists)
+
+	next := date(2026, 2, 28)
+	repo.EXPECT().Advance(mock.Anything, validUUID, 0, 1, &next).Return(true, nil)
+
+	created, err := svc.RunDue(context.Background(), date(2026, 2, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, created)
+}
+
+func TestRecurringService_RunDue_ItemErrorKeepsOccurrence(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	items := newMockitemCreator(t)
+	svc := NewRecurringService(repo, items, newTestCategories(t))
+
+	rec := newTestRecurring()
+	dbErr := errors.New("connection refused")
+	repo.EXPECT().ListDue(mock.Anything, mock.Anything).Return([]domain.RecurringItem{rec}, nil)
+	items.EXPECT().CreateScheduled(mock.Anything, mock.Anything).Return(domain.Item{}, dbErr)
+
+	created, err := svc.RunDue(context.Background(), date(2026, 2, 1))
+	assert.ErrorIs(t, err, dbErr)
+	assert.Equal(t, 0, created)
+	repo.AssertNotCalled(t, "Advance", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecurringService_Resume_SkipsPausedPeriod(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	svc := NewRecurringService(repo, newMockitemCreator(t), newTestCategories(t))
+
+	rec := newTestRecurring()
+	rec.Paused = true
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(rec, nil)
+	repo.EXPECT().UpdateSchedule(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, r domain.RecurringItem) (domain.RecurringItem, error) {
+			return r, nil
+		})
+
+	result, err := svc.Resume(context.Background(), validUUID, time.Date(2026, 4, 10, 8, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, result.Paused)
+	assert.Equal(t, 3, result.OccurrenceCount)
+	require.NotNil(t, result.NextRun)
+	assert.Equal(t, date(2026, 4, 30), *result.NextRun)
+}
+
+func TestRecurringService_Pause_NotFound(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	svc := NewRecurringService(repo, newMockitemCreator(t), newTestCategories(t))
+
+	repo.EXPECT().GetByID(mock.Anything, validUUID).Return(domain.RecurringItem{}, domain.ErrRecurringNotFound)
+
+	_, err := svc.Pause(context.Background(), validUUID)
+	assert.ErrorIs(t, err, domain.ErrRecurringNotFound)
+}
+
+func TestRecurringService_Preview_InvalidCount(t *testing.T) {
+	repo := newMockrecurringRepository(t)
+	svc := NewRecurringService(repo, newMockitemCreator(t), newTestCategories(t))
+
+	_, err := svc.Preview(context.Background(), validUUID, 0)
+	assert.ErrorIs(t, err, domain.ErrInvalidPreviewCount)
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/wb-go/wbf/helpers"
+)
+
+type transferRepository interface {
+	Create(ctx context.Context, from, to domain.Item) (domain.Transfer, error)
+	GetByID(ctx context.Context, id string) (domain.Transfer, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// accountLookup — счета, между которыми делается перевод.
+type accountLookup interface {
+	GetByID(ctx context.Context, id string) (domain.Account, error)
+}
+
+type TransferService struct {
+	repo     transferRepository
+	accounts accountLookup
+}
+
+func NewTransferService(repo transferRepository, accounts accountLookup) *TransferService {
+	return &TransferService{
+		repo:     repo,
+		accounts: accounts,
+	}
+}
+
+// Create создаёт перевод: расход со счёта-источника и доход на счёт-получатель в их валютах.
+// Для счетов в разных валютах сумма зачисления задаётся явно.
+func (s *TransferService) Create(ctx context.Context, p domain.TransferParams) (domain.Transfer, error) {
+	if p.FromAccountID == p.ToAccountID {
+		return domain.Transfer{}, domain.ErrSameAccount
+	}
+
+	from, err := s.openAccount(ctx, p.FromAccountID)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+	to, err := s.openAccount(ctx, p.ToAccountID)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	toAmount := p.Amount
+	if (from.Currency != to.Currency) != (p.ToAmount != nil) {
+		return domain.Transfer{}, domain.ErrTransferAmount
+	}
+	if p.ToAmount != nil {
+		toAmount = *p.ToAmount
+	}
+
+	leg := func(acc domain.Account, itemType string, amount decimal.Decimal) domain.Item {
+		return domain.Item{
+			Type:        itemType,
+			Amount:      amount,
+			Currency:    acc.Currency,
+			Category:    domain.TransferCategory,
+			Description: p.Description,
+			AccountID:   &acc.ID,
+			Date:        p.Date,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   p.CreatedAt,
+		}
+	}
+	transfer, err := s.repo.Create(ctx,
+		leg(from, domain.TypeExpense, p.Amount),
+		leg(to, domain.TypeIncome, toAmount),
+	)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+	return transfer, nil
+}
+
+func (s *TransferService) GetByID(ctx context.Context, id string) (domain.Transfer, error) {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.Transfer{}, domain.ErrInvalidID
+	}
+
+	transfer, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+	return transfer, nil
+}
+
+// Delete переносит обе половины перевода в корзину.
+func (s *TransferService) Delete(ctx context.Context, id string) error {
+	if err := helpers.ParseUUID(id); err != nil {
+		return domain.ErrInvalidID
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *TransferService) openAccount(ctx context.Context, id string) (domain.Account, error) {
+	acc, err := s.accounts.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrAccountNotFound) {
+			return domain.Account{}, fmt.Errorf("%w: %s", domain.ErrUnknownAccount, id)
+		}
+		return domain.Account{}, err
+	}
+	if acc.Closed {
+		return domain.Account{}, fmt.Errorf("%w: %s", domain.ErrAccountClosed, acc.Name)
+	}
+	return acc, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const otherUUID = "660e8400-e29b-41d4-a716-446655440001"
+
+func newTestTransferParams() domain.TransferParams {
+	return domain.TransferParams{
+		FromAccountID: validUUID,
+		ToAccountID:   otherUUID,
+		Amount:        decimal.NewFromInt(500),
+		Date:          time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestTransferService_Create_Success(t *testing.T) {
+	repo := newMocktransferRepository(t)
+	accounts := newMockaccountLookup(t)
+	svc := NewTransferService(repo, accounts)
+
+	savings := newTestAccount()
+	savings.ID = otherUUID
+	savings.Name = "savings"
+
+	accounts.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestAccount(), nil)
+	accounts.EXPECT().GetByID(mock.Anything, otherUUID).Return(savings, nil)
+	repo.EXPECT().Create(mock.Anything,
+		mock.MatchedBy(func(i domain.Item) bool {
+			return i.Type == domain.TypeExpense && *i.AccountID == validUUID &&
+				i.Category == domain.TransferCategory && i.Amount.Equal(decimal.NewFromInt(500))
+		}),
+		mock.MatchedBy(func(i domain.Item) bool {
+			return i.Type == domain.TypeIncome && *i.AccountID == otherUUID &&
+				i.Currency == "RUB" && i.Amount.Equal(decimal.NewFromInt(500))
+		}),
+	).Return(domain.Transfer{ID: validUUID}, nil)
+
+	result, err := svc.Create(context.Background(), newTestTransferParams())
+	require.NoError(t, err)
+	assert.Equal(t, validUUID, result.ID)
+}
+
+func TestTransferService_Create_SameAccount(t *testing.T) {
+	svc := NewTransferService(newMocktransferRepository(t), newMockaccountLookup(t))
+
+	p := newTestTransferParams()
+	p.ToAccountID = p.FromAccountID
+
+	_, err := svc.Create(context.Background(), p)
+	assert.ErrorIs(t, err, domain.ErrSameAccount)
+}
+
+func TestTransferService_Create_CurrencyWithoutToAmount(t *testing.T) {
+	accounts := newMockaccountLookup(t)
+	svc := NewTransferService(newMocktransferRepository(t), accounts)
+
+	usd := newTestAccount()
+	usd.ID = otherUUID
+	usd.Currency = "USD"
+
+	accounts.EXPECT().GetByID(mock.Anything, validUUID).Return(newTestAccount(), nil)
+	accounts.EXPECT().GetByID(mock.Anything, otherUUID).Return(usd, nil)
+
+	_, err := svc.Create(context.Background(), newTestTransferParams())
+	assert.ErrorIs(t, err, domain.ErrTransferAmount)
+}
+
+func TestTransferService_Create_ClosedAccount(t *testing.T) {
+	accounts := newMockaccountLookup(t)
+	svc := NewTransferService(newMocktransferRepository(t), accounts)
+
+	closed := newTestAccount()
+	closed.Closed = true
+	accounts.EXPECT().GetByID(mock.Anything, validUUID).Return(closed, nil)
+
+	_, err := svc.Create(context.Background(), newTestTransferParams())
+	assert.ErrorIs(t, err, domain.ErrAccountClosed)
+}
+
+func TestTransferService_Delete_InvalidUUID(t *testing.T) {
+	svc := NewTransferService(newMocktransferRepository(t), newMockaccountLookup(t))
+
+	err := svc.Delete(context.Background(), "bad")
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
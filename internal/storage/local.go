@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+)
+
+// Local хранит файлы в каталоге на диске, ключ — путь внутри каталога.
+type Local struct {
+	dir string
+}
+
+func NewLocal(dir string) (*Local, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create storage dir: %w", err)
+	}
+	return &Local{dir: dir}, nil
+}
+
+// Put записывает файл через временный файл и rename, чтобы читатели не видели его недописанным.
+func (l *Local) Put(_ context.Context, key string, r io.Reader) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create blob dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create temp blob: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // после успешного rename файла уже нет
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close blob: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename blob: %w", err)
+	}
+	return nil
+}
+
+func (l *Local) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("open blob: %w", err)
+	}
+	return f, nil
+}
+
+// Delete удаляет файл; отсутствующий файл ошибкой не считается.
+func (l *Local) Delete(_ context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete blob: %w", err)
+	}
+	return nil
+}
+
+// path переводит ключ в путь внутри каталога; ключи, выходящие за его пределы, отклоняются.
+func (l *Local) path(key string) (string, error) {
+	rel := filepath.FromSlash(key)
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(l.dir, rel), nil
+}
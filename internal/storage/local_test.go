@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stpnv0/SalesTracker/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_PutOpenDelete(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, s.Put(ctx, "item/receipt", strings.NewReader("content")))
+
+	r, err := s.Open(ctx, "item/receipt")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "content", string(data))
+
+	require.NoError(t, s.Delete(ctx, "item/receipt"))
+	_, err = s.Open(ctx, "item/receipt")
+	assert.ErrorIs(t, err, domain.ErrAttachmentNotFound)
+
+	assert.NoError(t, s.Delete(ctx, "item/receipt"), "deleting a missing blob is not an error")
+}
+
+func TestLocal_RejectsKeysOutsideDir(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"../escape", "/etc/passwd", ""} {
+		assert.Error(t, s.Put(ctx, key, strings.NewReader("x")), key)
+	}
+}
@@ -0,0 +1,30 @@
+// Package storage хранит содержимое вложений вне базы данных.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/stpnv0/SalesTracker/internal/config"
+)
+
+// BackendLocal — файлы на локальном диске.
+const BackendLocal = "local"
+
+// Storage — бэкенд хранения файлов по ключу. Ключи — относительные пути через "/".
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New создаёт хранилище, выбранное в конфиге.
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Backend {
+	case BackendLocal:
+		return NewLocal(cfg.LocalDir)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
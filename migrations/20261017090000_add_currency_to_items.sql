@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE items
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$');
+
+UPDATE items SET currency = 'USD'
+WHERE description IN ('seed__dividends_usd_02', 'seed__subscription_usd');
+
+CREATE INDEX idx_items_currency ON items (currency);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_items_currency;
+ALTER TABLE items DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE exchange_rates (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    date          DATE           NOT NULL,
+    from_currency CHAR(3)        NOT NULL CHECK (from_currency ~ '^[A-Z]{3}$'),
+    to_currency   CHAR(3)        NOT NULL CHECK (to_currency ~ '^[A-Z]{3}$'),
+    rate          NUMERIC(20, 8) NOT NULL CHECK (rate > 0),
+    created_at    TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    CHECK (from_currency <> to_currency),
+    UNIQUE (from_currency, to_currency, date)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS exchange_rates;
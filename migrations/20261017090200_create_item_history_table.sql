@@ -0,0 +1,16 @@
+-- +goose Up
+-- Без внешнего ключа на items: история удалённой записи должна сохраняться.
+CREATE TABLE item_history (
+    id         BIGSERIAL PRIMARY KEY,
+    item_id    UUID        NOT NULL,
+    action     VARCHAR(10) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    old_value  JSONB,
+    new_value  JSONB,
+    request_id TEXT        NOT NULL DEFAULT '',
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_item_history_item_id ON item_history (item_id, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_history;
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE items ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_items_deleted_at ON items (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE item_history DROP CONSTRAINT item_history_action_check;
+ALTER TABLE item_history ADD CONSTRAINT item_history_action_check
+    CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge'));
+
+-- +goose Down
+DELETE FROM item_history WHERE action IN ('restore', 'purge');
+ALTER TABLE item_history DROP CONSTRAINT item_history_action_check;
+ALTER TABLE item_history ADD CONSTRAINT item_history_action_check
+    CHECK (action IN ('create', 'update', 'delete'));
+
+DELETE FROM items WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_items_deleted_at;
+ALTER TABLE items DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE idempotency_keys (
+    key          VARCHAR(255) PRIMARY KEY,
+    request_hash CHAR(64)     NOT NULL,
+    status_code  INT,
+    headers      JSONB,
+    response     BYTEA,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    expires_at   TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE recurring_items (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    type             VARCHAR(10)    NOT NULL CHECK (type IN ('income', 'expense')),
+    amount           NUMERIC(15, 2) NOT NULL CHECK (amount > 0),
+    currency         CHAR(3)        NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$'),
+    category         VARCHAR(100)   NOT NULL,
+    description      TEXT           NOT NULL DEFAULT '',
+    frequency        VARCHAR(10)    NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    freq_interval    INT            NOT NULL DEFAULT 1 CHECK (freq_interval BETWEEN 1 AND 1000),
+    start_date       DATE           NOT NULL,
+    end_date         DATE           CHECK (end_date >= start_date),
+    occurrence_count INT            NOT NULL DEFAULT 0,
+    next_run         DATE,
+    paused           BOOLEAN        NOT NULL DEFAULT false,
+    created_at       TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_recurring_items_next_run ON recurring_items (next_run) WHERE NOT paused;
+
+-- +goose Down
+DROP TABLE IF EXISTS recurring_items;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE categories (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name       VARCHAR(100) NOT NULL UNIQUE,
+    parent_id  UUID REFERENCES categories (id) ON DELETE RESTRICT,
+    type       VARCHAR(10) CHECK (type IN ('income', 'expense')),
+    archived   BOOLEAN      NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    CHECK (parent_id <> id)
+);
+
+CREATE INDEX idx_categories_parent_id ON categories (parent_id);
+CREATE INDEX idx_categories_lower_name ON categories (lower(name));
+
+-- Категории, уже встречающиеся в записях и шаблонах; тип не ограничиваем.
+INSERT INTO categories (name)
+SELECT DISTINCT category FROM items
+UNION
+SELECT DISTINCT category FROM recurring_items;
+
+-- +goose Down
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE tags (
+    id   BIGSERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE
+);
+
+CREATE TABLE item_tags (
+    item_id UUID   NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    tag_id  BIGINT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (item_id, tag_id)
+);
+
+CREATE INDEX idx_item_tags_tag_id ON item_tags (tag_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_tags;
+DROP TABLE IF EXISTS tags;
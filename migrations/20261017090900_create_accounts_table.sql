@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE accounts (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name            VARCHAR(100)  NOT NULL UNIQUE,
+    currency        CHAR(3)       NOT NULL DEFAULT 'RUB',
+    opening_balance NUMERIC(15,2) NOT NULL DEFAULT 0,
+    closed          BOOLEAN       NOT NULL DEFAULT false,
+    created_at      TIMESTAMPTZ   NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ   NOT NULL DEFAULT now()
+);
+
+-- Существующие записи остаются без счёта.
+ALTER TABLE items ADD COLUMN account_id UUID REFERENCES accounts (id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_items_account_id_date ON items (account_id, date) WHERE account_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS account_id;
+DROP TABLE IF EXISTS accounts;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Перевод — две записи (расход со счёта-источника и доход на счёт-получатель) с общим transfer_id.
+ALTER TABLE items ADD COLUMN transfer_id UUID;
+ALTER TABLE items ADD CONSTRAINT items_transfer_account_check
+    CHECK (transfer_id IS NULL OR account_id IS NOT NULL);
+
+CREATE INDEX idx_items_transfer_id ON items (transfer_id) WHERE transfer_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE items DROP CONSTRAINT IF EXISTS items_transfer_account_check;
+ALTER TABLE items DROP COLUMN IF EXISTS transfer_id;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Разбивка записи по категориям: строки в сумме дают amount записи (проверяется в сервисе).
+CREATE TABLE item_splits (
+    item_id  UUID          NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    position INT           NOT NULL,
+    category VARCHAR(100)  NOT NULL,
+    amount   NUMERIC(15,2) NOT NULL CHECK (amount > 0),
+    PRIMARY KEY (item_id, position)
+);
+
+CREATE INDEX idx_item_splits_category ON item_splits (category);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_splits;
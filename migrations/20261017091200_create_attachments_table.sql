@@ -0,0 +1,16 @@
+-- +goose Up
+-- Метаданные вложений; сами файлы лежат в хранилище по storage_key.
+CREATE TABLE attachments (
+    id          UUID PRIMARY KEY,
+    item_id     UUID         NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    file_name   VARCHAR(255) NOT NULL,
+    mime_type   VARCHAR(100) NOT NULL,
+    size        BIGINT       NOT NULL CHECK (size > 0),
+    storage_key TEXT         NOT NULL UNIQUE,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_attachments_item_id ON attachments (item_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS attachments;
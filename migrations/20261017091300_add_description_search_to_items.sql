@@ -0,0 +1,11 @@
+-- +goose Up
+-- Полнотекстовый поиск по описанию. Конфигурация russian стеммит кириллицу русским,
+-- а латиницу английским стеммером, поэтому подходит для описаний на обоих языках.
+ALTER TABLE items ADD COLUMN description_tsv tsvector
+    GENERATED ALWAYS AS (to_tsvector('russian', description)) STORED;
+
+CREATE INDEX idx_items_description_tsv ON items USING GIN (description_tsv);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_items_description_tsv;
+ALTER TABLE items DROP COLUMN IF EXISTS description_tsv;
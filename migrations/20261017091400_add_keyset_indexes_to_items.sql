@@ -0,0 +1,10 @@
+-- +goose Up
+-- Индексы под keyset-пагинацию живых записей: сортировка всегда дополняется id.
+CREATE INDEX idx_items_live_date_id ON items (date, id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_items_live_amount_id ON items (amount, id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_items_live_category_id ON items (category, id) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_items_live_category_id;
+DROP INDEX IF EXISTS idx_items_live_amount_id;
+DROP INDEX IF EXISTS idx_items_live_date_id;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Категории, различающиеся только регистром (например, перенесённые из записей "food" и "Food"),
+-- сливаются в самую раннюю: записи, строки разбивки, шаблоны и подкатегории переходят на неё.
+CREATE TEMP TABLE category_dups AS
+SELECT c.id, c.name, k.id AS keep_id, k.name AS keep_name
+FROM categories c
+JOIN LATERAL (
+    SELECT id, name
+    FROM categories
+    WHERE lower(name) = lower(c.name)
+    ORDER BY created_at, name, id
+    LIMIT 1
+) k ON k.id <> c.id;
+
+UPDATE items i SET category = d.keep_name FROM category_dups d WHERE i.category = d.name;
+UPDATE item_splits s SET category = d.keep_name FROM category_dups d WHERE s.category = d.name;
+UPDATE recurring_items r SET category = d.keep_name FROM category_dups d WHERE r.category = d.name;
+UPDATE categories c SET parent_id = NULLIF(d.keep_id, c.id) FROM category_dups d WHERE c.parent_id = d.id;
+DELETE FROM categories c USING category_dups d WHERE c.id = d.id;
+
+DROP TABLE category_dups;
+
+-- Уникальность без учёта регистра теперь гарантирует база, а не только проверка в запросе.
+DROP INDEX IF EXISTS idx_categories_lower_name;
+CREATE UNIQUE INDEX idx_categories_lower_name ON categories (lower(name));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_categories_lower_name;
+CREATE INDEX idx_categories_lower_name ON categories (lower(name));
@@ -1,11 +1,13 @@
 "use strict";
 
 var API = "/api";
+var TIME_GROUPS = ["day", "week", "month", "quarter", "year"]; // для них пустые интервалы заполняются нулями
 
 // State.
 var currentSort = "date";
 var currentOrder = "desc";
 var editingId = null;
+var editingETag = null; // ETag открытой записи — уходит в If-Match при сохранении
 var itemsCache = []; // кеш для Edit (GET /items/:id не существует в роутере)
 
 // ------- Tabs -------
@@ -15,10 +17,14 @@ function switchTab(tab) {
     });
     document.getElementById("tab-items").classList.toggle("hidden", tab !== "items");
     document.getElementById("tab-analytics").classList.toggle("hidden", tab !== "analytics");
+    document.getElementById("tab-trash").classList.toggle("hidden", tab !== "trash");
 
     if (tab === "analytics") {
         loadAnalytics();
     }
+    if (tab === "trash") {
+        loadTrash();
+    }
 }
 
 // ------- Toast -------
@@ -39,11 +45,15 @@ function loadItems() {
     var to = document.getElementById("filter-to").value;
     var category = document.getElementById("filter-category").value;
     var type = document.getElementById("filter-type").value;
+    var tags = parseTags(document.getElementById("filter-tags").value);
+    var search = document.getElementById("filter-search").value.trim();
 
     if (from) params.set("from", from);
     if (to) params.set("to", to);
     if (category) params.set("category", category);
     if (type) params.set("type", type);
+    if (tags.length) params.set("tags", tags.join(","));
+    if (search) params.set("q", search);
     params.set("sort_by", currentSort);
     params.set("order", currentOrder);
 
@@ -78,9 +88,9 @@ function renderItems(items) {
         var dateStr = item.date ? item.date.substring(0, 10) : "";
         return '<tr>' +
             '<td><span class="badge ' + badgeClass + '">' + escapeHtml(item.type) + '</span></td>' +
-            '<td>' + Number(item.amount).toFixed(2) + '</td>' +
-            '<td>' + escapeHtml(item.category) + '</td>' +
-            '<td>' + escapeHtml(item.description || "") + '</td>' +
+            '<td>' + Number(item.amount).toFixed(2) + ' ' + escapeHtml(item.currency || "") + '</td>' +
+            '<td>' + escapeHtml(item.category) + renderSplits(item.splits) + '</td>' +
+            '<td>' + escapeHtml(item.description || "") + renderTags(item.tags) + '</td>' +
             '<td>' + dateStr + '</td>' +
             '<td>' +
             '<button class="btn btn-outline btn-sm" onclick="openEditModal(\'' + item.id + '\')">Edit</button> ' +
@@ -94,8 +104,12 @@ function saveItem() {
     var body = {
         type: document.getElementById("item-type").value,
         amount: parseFloat(document.getElementById("item-amount").value),
+        currency: document.getElementById("item-currency").value.trim().toUpperCase(),
         category: document.getElementById("item-category").value.trim(),
         description: document.getElementById("item-description").value.trim(),
+        tags: parseTags(document.getElementById("item-tags").value),
+        splits: parseSplits(document.getElementById("item-splits").value),
+        account_id: document.getElementById("item-account").value,
         date: document.getElementById("item-date").value
     };
 
@@ -124,12 +138,12 @@ function saveItem() {
 }
 
 function deleteItem(id) {
-    if (!confirm("Delete this item?")) return;
+    if (!confirm("Move this item to trash?")) return;
 
     fetch(API + "/items/" + id, { method: "DELETE" })
         .then(function (res) {
             if (!res.ok) return res.json().then(function (e) { throw new Error(e.error); });
-            showToast("Item deleted", "success");
+            showToast("Item moved to trash", "success");
             loadItems();
         })
         .catch(function (err) {
@@ -137,18 +151,93 @@ function deleteItem(id) {
         });
 }
 
+// ------- Trash -------
+function loadTrash() {
+    fetch(API + "/trash?limit=1000")
+        .then(function (res) {
+            if (!res.ok) return res.json().then(function (e) { throw new Error(e.error); });
+            return res.json();
+        })
+        .then(function (data) {
+            renderTrash(data.items);
+        })
+        .catch(function (err) {
+            showToast(err.message, "error");
+        });
+}
+
+function renderTrash(items) {
+    var tbody = document.getElementById("trash-table-body");
+    var empty = document.getElementById("empty-trash");
+
+    if (!items || items.length === 0) {
+        tbody.innerHTML = "";
+        empty.classList.remove("hidden");
+        return;
+    }
+
+    empty.classList.add("hidden");
+    tbody.innerHTML = items.map(function (item) {
+        var badgeClass = item.type === "income" ? "badge-income" : "badge-expense";
+        return '<tr>' +
+            '<td><span class="badge ' + badgeClass + '">' + escapeHtml(item.type) + '</span></td>' +
+            '<td>' + Number(item.amount).toFixed(2) + ' ' + escapeHtml(item.currency || "") + '</td>' +
+            '<td>' + escapeHtml(item.category) + '</td>' +
+            '<td>' + (item.date ? item.date.substring(0, 10) : "") + '</td>' +
+            '<td>' + (item.deleted_at ? item.deleted_at.substring(0, 10) : "") + '</td>' +
+            '<td><button class="btn btn-primary btn-sm" onclick="restoreItem(\'' + item.id + '\')">Restore</button></td>' +
+            '</tr>';
+    }).join("");
+}
+
+function restoreItem(id) {
+    fetch(API + "/items/" + id + "/restore", { method: "POST" })
+        .then(function (res) {
+            if (!res.ok) return res.json().then(function (e) { throw new Error(e.error); });
+            showToast("Item restored", "success");
+            loadTrash();
+            loadItems();
+        })
+        .catch(function (err) {
+            showToast(err.message, "error");
+        });
+}
+
+function purgeTrash() {
+    var days = document.getElementById("purge-days").value || "30";
+    if (!confirm("Permanently delete items trashed more than " + days + " days ago?")) return;
+
+    fetch(API + "/trash?older_than_days=" + encodeURIComponent(days), { method: "DELETE" })
+        .then(function (res) {
+            if (!res.ok) return res.json().then(function (e) { throw new Error(e.error); });
+            return res.json();
+        })
+        .then(function (data) {
+            showToast("Purged " + data.purged + " item(s)", "success");
+            loadTrash();
+        })
+        .catch(function (err) {
+            showToast(err.message, "error");
+        });
+}
+
 function openEditModal(id) {
     fetch(API + "/items/" + id)
         .then(function (res) {
             if (!res.ok) return res.json().then(function (e) { throw new Error(e.error); });
+            editingETag = res.headers.get("ETag");
             return res.json();
         })
         .then(function (item) {
             editingId = id;
             document.getElementById("modal-type").value = item.type;
             document.getElementById("modal-amount").value = item.amount;
+            document.getElementById("modal-currency").value = item.currency || "";
             document.getElementById("modal-category").value = item.category;
             document.getElementById("modal-description").value = item.description || "";
+            document.getElementById("modal-tags").value = (item.tags || []).join(", ");
+            document.getElementById("modal-splits").value = formatSplits(item.splits);
+            document.getElementById("modal-account").value = item.account_id || "";
             document.getElementById("modal-date").value = item.date ? item.date.substring(0, 10) : "";
             document.getElementById("edit-modal").classList.add("show");
         })
@@ -159,6 +248,7 @@ function openEditModal(id) {
 
 function closeModal() {
     editingId = null;
+    editingETag = null;
     document.getElementById("edit-modal").classList.remove("show");
 }
 
@@ -168,17 +258,25 @@ function updateItem() {
     var body = {
         type: document.getElementById("modal-type").value,
         amount: parseFloat(document.getElementById("modal-amount").value),
+        currency: document.getElementById("modal-currency").value.trim().toUpperCase(),
         category: document.getElementById("modal-category").value.trim(),
         description: document.getElementById("modal-description").value.trim(),
+        tags: parseTags(document.getElementById("modal-tags").value),
+        splits: parseSplits(document.getElementById("modal-splits").value),
+        account_id: document.getElementById("modal-account").value,
         date: document.getElementById("modal-date").value
     };
 
+    var headers = { "Content-Type": "application/json" };
+    if (editingETag) headers["If-Match"] = editingETag;
+
     fetch(API + "/items/" + editingId, {
         method: "PUT",
-        headers: { "Content-Type": "application/json" },
+        headers: headers,
         body: JSON.stringify(body)
     })
         .then(function (res) {
+            if (res.status === 412) throw new Error("Item was changed by someone else, reopen it to see the latest version");
             if (!res.ok) return res.json().then(function (e) { throw new Error(e.error); });
             return res.json();
         })
@@ -195,8 +293,12 @@ function updateItem() {
 function clearForm() {
     document.getElementById("item-type").value = "income";
     document.getElementById("item-amount").value = "";
+    document.getElementById("item-currency").value = "RUB";
     document.getElementById("item-category").value = "";
     document.getElementById("item-description").value = "";
+    document.getElementById("item-tags").value = "";
+    document.getElementById("item-splits").value = "";
+    document.getElementById("item-account").value = "";
     document.getElementById("item-date").value = todayStr();
 }
 
@@ -221,7 +323,8 @@ function loadAnalytics() {
     var to = document.getElementById("analytics-to").value;
     var groupBy = document.getElementById("analytics-group").value;
     var type = document.getElementById("analytics-type").value;
-
+    var baseCurrency = document.getElementById("analytics-base-currency").value.trim().toUpperCase();
+    var transfers = document.getElementById("analytics-transfers").value;
 
     if (!from || !to) {
         showToast("Please select 'from' and 'to' dates for analytics.", "error");
@@ -232,7 +335,10 @@ function loadAnalytics() {
     params.set("from", from);
     params.set("to", to);
     if (groupBy) params.set("group_by", groupBy);
+    if (TIME_GROUPS.indexOf(groupBy) !== -1) params.set("fill", "zero");
     if (type) params.set("type", type);
+    if (baseCurrency) params.set("base_currency", baseCurrency);
+    if (transfers) params.set("include_transfers", transfers);
 
     fetch(API + "/analytics?" + params.toString())
         .then(function (res) {
@@ -248,20 +354,47 @@ function loadAnalytics() {
 }
 
 function renderAnalytics(data) {
-    document.getElementById("stat-count").textContent = data.count;
-    document.getElementById("stat-sum").textContent = Number(data.total_sum).toFixed(2);
-    document.getElementById("stat-avg").textContent = Number(data.avg).toFixed(2);
-    document.getElementById("stat-median").textContent = Number(data.median).toFixed(2);
-    document.getElementById("stat-p90").textContent = Number(data.p90).toFixed(2);
+    // Суммы в разных валютах не складываются — по строке на каждую валюту.
+    var results = data.currencies || [];
+    var perCurrency = function (field, digits) {
+        if (results.length === 0) return "-";
+        return results.map(function (r) {
+            var v = digits === undefined ? r[field] : Number(r[field]).toFixed(digits);
+            return v + " " + escapeHtml(r.currency);
+        }).join("<br>");
+    };
+    document.getElementById("stat-count").innerHTML = perCurrency("count");
+    document.getElementById("stat-sum").innerHTML = perCurrency("total_sum", 2);
+    document.getElementById("stat-avg").innerHTML = perCurrency("avg", 2);
+    document.getElementById("stat-median").innerHTML = perCurrency("median", 2);
+    document.getElementById("stat-p90").innerHTML = perCurrency("p90", 2);
+
+    if (data.unconverted && data.unconverted.length > 0) {
+        showToast(data.unconverted.length + " item(s) skipped: no " + data.base_currency + " rate", "error");
+    }
 
     var groupsCard = document.getElementById("groups-card");
     var groupsTbody = document.getElementById("groups-table-body");
 
-    if (data.groups && data.groups.length > 0) {
+    // Дерево категорий разворачивается в плоский список с отступом по глубине.
+    var groups = [];
+    var flatten = function (list, depth) {
+        list.forEach(function (g) {
+            groups.push({ group: g, depth: depth });
+            flatten(g.children || [], depth + 1);
+        });
+    };
+    results.forEach(function (r) {
+        flatten(r.groups || [], 0);
+    });
+
+    if (groups.length > 0) {
         groupsCard.classList.remove("hidden");
-        groupsTbody.innerHTML = data.groups.map(function (g) {
+        groupsTbody.innerHTML = groups.map(function (row) {
+            var g = row.group;
             return '<tr>' +
-                '<td>' + escapeHtml(g.key) + '</td>' +
+                '<td style="padding-left: ' + (12 + row.depth * 20) + 'px">' + escapeHtml(g.key) + '</td>' +
+                '<td>' + escapeHtml(g.currency) + '</td>' +
                 '<td>' + g.count + '</td>' +
                 '<td>' + Number(g.total_sum).toFixed(2) + '</td>' +
                 '<td>' + Number(g.avg).toFixed(2) + '</td>' +
@@ -282,15 +415,54 @@ function exportCSV() {
     var to = document.getElementById("filter-to").value;
     var category = document.getElementById("filter-category").value;
     var type = document.getElementById("filter-type").value;
+    var tags = parseTags(document.getElementById("filter-tags").value);
+    var search = document.getElementById("filter-search").value.trim();
 
     if (from) params.set("from", from);
     if (to) params.set("to", to);
     if (category) params.set("category", category);
     if (type) params.set("type", type);
+    if (tags.length) params.set("tags", tags.join(","));
+    if (search) params.set("q", search);
 
     window.location.href = API + "/export/csv?" + params.toString();
 }
 
+// ------- Categories -------
+function loadCategories() {
+    fetch(API + "/categories")
+        .then(function (res) { return res.json(); })
+        .then(function (data) {
+            var list = document.getElementById("category-options");
+            list.innerHTML = "";
+            (data.categories || []).forEach(function (cat) {
+                var opt = document.createElement("option");
+                opt.value = cat.name;
+                list.appendChild(opt);
+            });
+        })
+        .catch(function () {});
+}
+
+// ------- Accounts -------
+// Закрытые счета тоже загружаются: иначе при редактировании их записи потеряли бы счёт.
+function loadAccounts() {
+    fetch(API + "/accounts?include_closed=true")
+        .then(function (res) { return res.json(); })
+        .then(function (data) {
+            document.querySelectorAll(".account-select").forEach(function (select) {
+                select.innerHTML = '<option value="">No account</option>';
+                (data.accounts || []).forEach(function (acc) {
+                    var opt = document.createElement("option");
+                    opt.value = acc.id;
+                    opt.textContent = acc.name + " (" + acc.currency + ")" + (acc.closed ? " — closed" : "");
+                    select.appendChild(opt);
+                });
+            });
+        })
+        .catch(function () {});
+}
+
 // ------- Helpers -------
 function escapeHtml(text) {
     var div = document.createElement("div");
@@ -298,6 +470,46 @@ function escapeHtml(text) {
     return div.innerHTML;
 }
 
+function parseTags(text) {
+    return text.split(",")
+        .map(function (t) { return t.trim(); })
+        .filter(function (t) { return t !== ""; });
+}
+
+// parseSplits разбирает строку вида "Food: 700, Health: 300".
+function parseSplits(text) {
+    return text.split(",")
+        .map(function (part) { return part.trim(); })
+        .filter(function (part) { return part !== ""; })
+        .map(function (part) {
+            var idx = part.lastIndexOf(":");
+            return {
+                category: idx < 0 ? part : part.substring(0, idx).trim(),
+                amount: idx < 0 ? NaN : parseFloat(part.substring(idx + 1))
+            };
+        });
+}
+
+function formatSplits(splits) {
+    return (splits || []).map(function (s) {
+        return s.category + ": " + s.amount;
+    }).join(", ");
+}
+
+function renderSplits(splits) {
+    if (!splits || splits.length === 0) return "";
+    return '<div class="splits">' + splits.map(function (s) {
+        return escapeHtml(s.category) + " " + Number(s.amount).toFixed(2);
+    }).join(" · ") + '</div>';
+}
+
+function renderTags(tags) {
+    if (!tags || tags.length === 0) return "";
+    return " " + tags.map(function (t) {
+        return '<span class="badge badge-tag">' + escapeHtml(t) + '</span>';
+    }).join(" ");
+}
+
 function todayStr() {
     var d = new Date();
     return d.getFullYear() + "-" +
@@ -316,5 +528,7 @@ function todayStr() {
         String(thirtyDaysAgo.getDate()).padStart(2, "0");
     document.getElementById("analytics-to").value = todayStr();
 
+    loadCategories();
+    loadAccounts();
     loadItems();
 })();
\ No newline at end of file